@@ -0,0 +1,223 @@
+// Package assets tracks the client-side assets components need while a page
+// renders, so a deferred slot (head.Deferred) can include exactly those
+// assets, each once.
+package assets
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/a-h/templ"
+)
+
+// Asset is an embedded script, named by its file in head.Assets.
+type Asset string
+
+// Scripts components can require.
+const (
+	HTMX        Asset = "htmx.min.js"
+	Hyperscript Asset = "_hyperscript.min.js"
+)
+
+// Style is a stylesheet required by a component, deduplicated by ID.
+type Style struct {
+	ID  string
+	CSS string
+}
+
+// Pending lists required assets that have not been included yet.
+type Pending struct {
+	Assets     []Asset  // Scripts, in the order they were first required
+	Extensions []string // HTMX extension names
+	Styles     []Style  // Stylesheets
+}
+
+// Registry collects the assets required during a render. The zero value is
+// not usable; use NewRegistry. Methods on a nil Registry do nothing, so
+// components work without one.
+type Registry struct {
+	mu         sync.Mutex
+	required   []Asset
+	extensions []string
+	styles     []Style
+	included   map[string]bool
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{included: map[string]bool{}}
+}
+
+type contextKey struct{}
+
+// WithRegistry returns a context carrying a new Registry, or ctx unchanged if
+// it already carries one.
+func WithRegistry(ctx context.Context) context.Context {
+	if FromContext(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, NewRegistry())
+}
+
+// FromContext returns the Registry set on ctx, or nil if none is set.
+func FromContext(ctx context.Context) *Registry {
+	registry, _ := ctx.Value(contextKey{}).(*Registry)
+	return registry
+}
+
+// Require records that the given scripts are needed.
+func (r *Registry) Require(assets ...Asset) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, asset := range assets {
+		if !contains(r.required, asset) {
+			r.required = append(r.required, asset)
+		}
+	}
+}
+
+// RequireExtension records that the given HTMX extensions, and HTMX itself,
+// are needed.
+func (r *Registry) RequireExtension(names ...string) {
+	if r == nil {
+		return
+	}
+	r.Require(HTMX)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, name := range names {
+		if !contains(r.extensions, name) {
+			r.extensions = append(r.extensions, name)
+		}
+	}
+}
+
+// RequireCSS records a stylesheet, ignoring IDs already required.
+func (r *Registry) RequireCSS(id, css string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, style := range r.styles {
+		if style.ID == id {
+			return
+		}
+	}
+	r.styles = append(r.styles, Style{ID: id, CSS: css})
+}
+
+// Include marks an asset, extension or style ID as included in the page, so
+// it is not returned by Pending.
+func (r *Registry) Include(name string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.included[name] = true
+}
+
+// Included reports whether name has been marked as included.
+func (r *Registry) Included(name string) bool {
+	if r == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.included[name]
+}
+
+// Pending returns the required assets, extensions and styles that have not
+// been marked as included.
+func (r *Registry) Pending() Pending {
+	var pending Pending
+	if r == nil {
+		return pending
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, asset := range r.required {
+		if !r.included[string(asset)] {
+			pending.Assets = append(pending.Assets, asset)
+		}
+	}
+	for _, name := range r.extensions {
+		if !r.included[name] {
+			pending.Extensions = append(pending.Extensions, name)
+		}
+	}
+	for _, style := range r.styles {
+		if !r.included[style.ID] {
+			pending.Styles = append(pending.Styles, style)
+		}
+	}
+	return pending
+}
+
+// contains reports whether items contains item.
+func contains[T comparable](items []T, item T) bool {
+	for _, existing := range items {
+		if existing == item {
+			return true
+		}
+	}
+	return false
+}
+
+// Require returns a component that renders nothing and records the given
+// scripts in the render's Registry, if any.
+func Require(assets ...Asset) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		FromContext(ctx).Require(assets...)
+		return nil
+	})
+}
+
+// RequireExtension returns a component that renders nothing and records the
+// given HTMX extensions in the render's Registry, if any.
+func RequireExtension(names ...string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		FromContext(ctx).RequireExtension(names...)
+		return nil
+	})
+}
+
+// RequireCSS returns a component recording a stylesheet in the render's
+// Registry, for head.Deferred to include; it renders nothing. Without a
+// registry it writes the stylesheet inline instead, once per render, in a
+// style element carrying the CSP nonce set with templ.WithNonce, if any.
+func RequireCSS(id, css string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if registry := FromContext(ctx); registry != nil {
+			registry.RequireCSS(id, css)
+			return nil
+		}
+		handle, _ := inlineStyles.LoadOrStore(id, templ.NewOnceHandle(templ.WithComponent(inlineStyle(css))))
+		return handle.(*templ.OnceHandle).Once().Render(ctx, w)
+	})
+}
+
+// inlineStyles holds a templ.OnceHandle per style ID, used by RequireCSS
+// when rendering without a registry.
+var inlineStyles sync.Map
+
+// inlineStyle returns a component writing css in a style element.
+func inlineStyle(css string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if _, err := io.WriteString(w, "<style"); err != nil {
+			return err
+		}
+		if nonce := templ.GetNonce(ctx); nonce != "" {
+			if err := templ.RenderAttributes(ctx, w, templ.Attributes{"nonce": nonce}); err != nil {
+				return err
+			}
+		}
+		_, err := io.WriteString(w, ">"+css+"</style>")
+		return err
+	})
+}
@@ -0,0 +1,130 @@
+package assets
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestRegistry_RequireDeduplicates(t *testing.T) {
+	r := NewRegistry()
+	r.Require(Hyperscript)
+	r.Require(HTMX, Hyperscript)
+
+	pending := r.Pending()
+	if want := []Asset{Hyperscript, HTMX}; !reflect.DeepEqual(pending.Assets, want) {
+		t.Errorf("expected %v, got %v", want, pending.Assets)
+	}
+}
+
+func TestRegistry_RequireExtensionImpliesHTMX(t *testing.T) {
+	r := NewRegistry()
+	r.RequireExtension("sse", "ws")
+	r.RequireExtension("sse")
+
+	pending := r.Pending()
+	if want := []Asset{HTMX}; !reflect.DeepEqual(pending.Assets, want) {
+		t.Errorf("expected %v, got %v", want, pending.Assets)
+	}
+	if want := []string{"sse", "ws"}; !reflect.DeepEqual(pending.Extensions, want) {
+		t.Errorf("expected %v, got %v", want, pending.Extensions)
+	}
+}
+
+func TestRegistry_RequireCSSDeduplicatesByID(t *testing.T) {
+	r := NewRegistry()
+	r.RequireCSS("chart", ".chart{}")
+	r.RequireCSS("chart", ".other{}")
+
+	pending := r.Pending()
+	if want := []Style{{ID: "chart", CSS: ".chart{}"}}; !reflect.DeepEqual(pending.Styles, want) {
+		t.Errorf("expected %v, got %v", want, pending.Styles)
+	}
+}
+
+func TestRegistry_PendingSkipsIncluded(t *testing.T) {
+	r := NewRegistry()
+	r.Require(HTMX, Hyperscript)
+	r.RequireCSS("chart", ".chart{}")
+	r.Include(string(HTMX))
+	r.Include("chart")
+
+	pending := r.Pending()
+	if want := []Asset{Hyperscript}; !reflect.DeepEqual(pending.Assets, want) {
+		t.Errorf("expected %v, got %v", want, pending.Assets)
+	}
+	if len(pending.Styles) != 0 {
+		t.Errorf("expected no styles, got %v", pending.Styles)
+	}
+	if !r.Included(string(HTMX)) || r.Included(string(Hyperscript)) {
+		t.Error("expected only HTMX to be included")
+	}
+}
+
+func TestRegistry_NilIsNoOp(t *testing.T) {
+	var r *Registry
+	r.Require(HTMX)
+	r.RequireExtension("sse")
+	r.RequireCSS("chart", ".chart{}")
+	r.Include("chart")
+
+	if r.Included("chart") {
+		t.Error("expected nil registry to include nothing")
+	}
+	if pending := r.Pending(); !reflect.DeepEqual(pending, Pending{}) {
+		t.Errorf("expected nothing pending, got %v", pending)
+	}
+}
+
+func TestWithRegistryReusesExisting(t *testing.T) {
+	ctx := WithRegistry(context.Background())
+
+	if FromContext(ctx) == nil {
+		t.Fatal("expected registry in context")
+	}
+	if FromContext(WithRegistry(ctx)) != FromContext(ctx) {
+		t.Error("expected nested WithRegistry to reuse the registry")
+	}
+	if FromContext(context.Background()) != nil {
+		t.Error("expected no registry in background context")
+	}
+}
+
+func TestRequireComponents(t *testing.T) {
+	ctx := WithRegistry(context.Background())
+	var buf bytes.Buffer
+	if err := Require(Hyperscript).Render(ctx, &buf); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	if err := RequireExtension("sse").Render(ctx, &buf); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	if err := RequireCSS("chart", ".chart{}").Render(ctx, &buf); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("expected no output, got %q", buf.String())
+	}
+	pending := FromContext(ctx).Pending()
+	if len(pending.Assets) != 2 || len(pending.Extensions) != 1 || len(pending.Styles) != 1 {
+		t.Errorf("expected requirements to be recorded, got %v", pending)
+	}
+}
+
+func TestRequireCSSWithoutRegistryRendersInlineOnce(t *testing.T) {
+	ctx := templ.WithNonce(templ.InitializeContext(context.Background()), "abc123")
+	var buf bytes.Buffer
+	for i := 0; i < 2; i++ {
+		if err := RequireCSS("inline-chart", ".chart{}").Render(ctx, &buf); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+	}
+
+	if want := `<style nonce="abc123">.chart{}</style>`; buf.String() != want {
+		t.Errorf("expected %s, got %s", want, buf.String())
+	}
+}
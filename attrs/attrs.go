@@ -1,7 +1,24 @@
 // Package attrs provides common attribute helpers for pico_templ components.
 package attrs
 
-// HtmxAttrs contains HTMX attribute bindings for components.
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
+)
+
+// HtmxAttrs contains HTMX attribute bindings for components, covering the
+// htmx 2 attribute set except the deprecated hx-vars. Spread a value into an
+// element to render the attributes that are set, and render Require before
+// it:
+//
+//	@props.Htmx.Require()
+//	<input { props.Htmx... }/>
 type HtmxAttrs struct {
 	Get       string // hx-get URL
 	Post      string // hx-post URL
@@ -9,16 +26,289 @@ type HtmxAttrs struct {
 	Delete    string // hx-delete URL
 	Patch     string // hx-patch URL
 	Target    string // hx-target selector
-	Swap      string // hx-swap strategy
-	Trigger   string // hx-trigger event
+	Swap      string // hx-swap value, e.g. built with Swap.String
+	Trigger   string // hx-trigger value, e.g. built with Triggers.String
 	Confirm   string // hx-confirm message
 	Indicator string // hx-indicator selector
-	PushURL   string // hx-push-url
+	PushURL   string // hx-push-url: "true", "false" or a URL
 	Select    string // hx-select selector
 	Vals      string // hx-vals JSON
+	// Request
+	Include      string   // hx-include selector
+	Headers      string   // hx-headers JSON
+	Params       string   // hx-params: "*", "none", "not a,b" or "a,b"
+	Encoding     Encoding // hx-encoding
+	Sync         string   // hx-sync, e.g. "closest form:abort"
+	Prompt       string   // hx-prompt message
+	Request      string   // hx-request configuration, e.g. "timeout:3000"
+	ValidateForm bool     // hx-validate="true": validate the form before requesting
+	DisabledElt  string   // hx-disabled-elt selector, disabled during the request
+	// Response and swapping
+	SwapOOB    string // hx-swap-oob: "true", a strategy or strategy:selector
+	SelectOOB  string // hx-select-oob selectors
+	ReplaceURL string // hx-replace-url: "true", "false" or a URL
+	Preserve   bool   // hx-preserve: keep the element unchanged between swaps
+	// Behavior
+	Boost      Flag              // hx-boost
+	Ext        string            // hx-ext: comma separated extension names
+	Disable    bool              // hx-disable: turn off HTMX processing for the element
+	Disinherit string            // hx-disinherit: "*" or attribute names
+	Inherit    string            // hx-inherit: "*" or attribute names
+	History    Flag              // hx-history: FlagFalse keeps the page out of the history cache
+	HistoryElt bool              // hx-history-elt: snapshot this element for history
+	On         map[string]string // hx-on:<event> handlers keyed by event, e.g. "click", "htmx:after-request" or ":after-request"
+}
+
+// Flag is the value of an HTMX attribute that is either "true" or "false".
+// Setting FlagFalse overrides a value inherited from an ancestor.
+type Flag string
+
+// Flag values; FlagUnset omits the attribute.
+const (
+	FlagUnset Flag = ""
+	FlagTrue  Flag = "true"
+	FlagFalse Flag = "false"
+)
+
+// Validate returns an error if f is not a supported value.
+func (f Flag) Validate() error {
+	switch f {
+	case FlagUnset, FlagTrue, FlagFalse:
+		return nil
+	}
+	return fmt.Errorf("attrs: invalid HTMX flag %q", string(f))
+}
+
+// Encoding is the hx-encoding request body encoding.
+type Encoding string
+
+// Encodings supported by hx-encoding; EncodingDefault omits the attribute.
+const (
+	EncodingDefault   Encoding = ""
+	EncodingMultipart Encoding = "multipart/form-data"
+)
+
+// Validate returns an error if e is not a supported encoding.
+func (e Encoding) Validate() error {
+	switch e {
+	case EncodingDefault, EncodingMultipart:
+		return nil
+	}
+	return fmt.Errorf("attrs: invalid hx-encoding %q", string(e))
 }
 
-// HasHtmx returns true if any HTMX attributes are set.
+// eventName matches the event names accepted in hx-on keys.
+var eventName = regexp.MustCompile(`^:?[A-Za-z0-9_.-]+(:[A-Za-z0-9_.-]+)*$`)
+
+// Validate returns an error if Boost, History or Encoding is not a supported
+// value, or an On key is not a valid event name. Swap and Trigger are passed
+// through unchecked, so extension swap styles and any trigger htmx accepts
+// keep working; use ValidateStrict to check them too.
+func (h HtmxAttrs) Validate() error {
+	if err := h.Boost.Validate(); err != nil {
+		return fmt.Errorf("attrs: invalid hx-boost: %w", err)
+	}
+	if err := h.History.Validate(); err != nil {
+		return fmt.Errorf("attrs: invalid hx-history: %w", err)
+	}
+	if err := h.Encoding.Validate(); err != nil {
+		return err
+	}
+	for event := range h.On {
+		if !eventName.MatchString(event) {
+			return fmt.Errorf("attrs: invalid hx-on event %q", event)
+		}
+	}
+	return nil
+}
+
+// ValidateStrict returns an error if Validate fails, or if Swap or Trigger
+// is not accepted by ParseSwap or ParseTriggers. It rejects swap styles
+// added by extensions, such as morph.
+func (h HtmxAttrs) ValidateStrict() error {
+	if err := h.Validate(); err != nil {
+		return err
+	}
+	if h.Swap != "" {
+		if _, err := ParseSwap(h.Swap); err != nil {
+			return err
+		}
+	}
+	if h.Trigger != "" {
+		if _, err := ParseTriggers(h.Trigger); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasHtmx returns true if any HTMX request URL (Get, Post, Put, Delete or
+// Patch) is set.
 func (h HtmxAttrs) HasHtmx() bool {
 	return h.Get != "" || h.Post != "" || h.Put != "" || h.Delete != "" || h.Patch != ""
 }
+
+// IsZero reports whether no HTMX attribute is set. Components require HTMX
+// on the page unless it is.
+func (h HtmxAttrs) IsZero() bool {
+	return len(h.Items()) == 0
+}
+
+// Require returns a component that renders nothing. It fails the render if
+// Validate fails, and otherwise records HTMX in the render's asset registry
+// unless h is zero. Extensions named in Ext are not required; include them
+// with head.Props.Extensions.
+func (h HtmxAttrs) Require() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if err := h.Validate(); err != nil {
+			return err
+		}
+		if !h.IsZero() {
+			assets.FromContext(ctx).Require(assets.HTMX)
+		}
+		return nil
+	})
+}
+
+// Items returns the hx-* attributes that are set, in a fixed order with the
+// hx-on handlers last, sorted by event. It makes HtmxAttrs a
+// templ.Attributer.
+func (h HtmxAttrs) Items() []templ.KeyValue[string, any] {
+	var items []templ.KeyValue[string, any]
+	for _, attr := range []struct{ key, value string }{
+		{"hx-get", h.Get},
+		{"hx-post", h.Post},
+		{"hx-put", h.Put},
+		{"hx-delete", h.Delete},
+		{"hx-patch", h.Patch},
+		{"hx-target", h.Target},
+		{"hx-swap", h.Swap},
+		{"hx-trigger", h.Trigger},
+		{"hx-confirm", h.Confirm},
+		{"hx-indicator", h.Indicator},
+		{"hx-push-url", h.PushURL},
+		{"hx-select", h.Select},
+		{"hx-vals", h.Vals},
+		{"hx-include", h.Include},
+		{"hx-headers", h.Headers},
+		{"hx-params", h.Params},
+		{"hx-encoding", string(h.Encoding)},
+		{"hx-sync", h.Sync},
+		{"hx-prompt", h.Prompt},
+		{"hx-request", h.Request},
+		{"hx-validate", flag(h.ValidateForm)},
+		{"hx-disabled-elt", h.DisabledElt},
+		{"hx-swap-oob", h.SwapOOB},
+		{"hx-select-oob", h.SelectOOB},
+		{"hx-replace-url", h.ReplaceURL},
+		{"hx-preserve", flag(h.Preserve)},
+		{"hx-boost", string(h.Boost)},
+		{"hx-ext", h.Ext},
+		{"hx-disinherit", h.Disinherit},
+		{"hx-inherit", h.Inherit},
+		{"hx-history", string(h.History)},
+	} {
+		if attr.value != "" {
+			items = append(items, templ.KeyValue[string, any]{Key: attr.key, Value: attr.value})
+		}
+	}
+	// hx-disable and hx-history-elt are checked for presence only.
+	if h.Disable {
+		items = append(items, templ.KeyValue[string, any]{Key: "hx-disable", Value: true})
+	}
+	if h.HistoryElt {
+		items = append(items, templ.KeyValue[string, any]{Key: "hx-history-elt", Value: true})
+	}
+	events := make([]string, 0, len(h.On))
+	for event := range h.On {
+		events = append(events, event)
+	}
+	sort.Strings(events)
+	for _, event := range events {
+		items = append(items, templ.KeyValue[string, any]{Key: "hx-on:" + event, Value: h.On[event]})
+	}
+	return items
+}
+
+// Attributes returns the hx-* attributes that are set as a map, for
+// combining with other attributes through Merge.
+func (h HtmxAttrs) Attributes() templ.Attributes {
+	attributes := templ.Attributes{}
+	for _, item := range h.Items() {
+		attributes[item.Key] = item.Value
+	}
+	return attributes
+}
+
+// flag returns "true" if b is set, or an empty string.
+func flag(b bool) string {
+	if b {
+		return "true"
+	}
+	return ""
+}
+
+// WithDefaults returns h with each unset field set from defaults, and with
+// the On handlers of defaults added for events h does not handle. Components
+// use it to fold their deprecated flat Hx* fields into an HtmxAttrs value.
+func (h HtmxAttrs) WithDefaults(defaults HtmxAttrs) HtmxAttrs {
+	for _, field := range []struct {
+		value    *string
+		fallback string
+	}{
+		{&h.Get, defaults.Get},
+		{&h.Post, defaults.Post},
+		{&h.Put, defaults.Put},
+		{&h.Delete, defaults.Delete},
+		{&h.Patch, defaults.Patch},
+		{&h.Target, defaults.Target},
+		{&h.Swap, defaults.Swap},
+		{&h.Trigger, defaults.Trigger},
+		{&h.Confirm, defaults.Confirm},
+		{&h.Indicator, defaults.Indicator},
+		{&h.PushURL, defaults.PushURL},
+		{&h.Select, defaults.Select},
+		{&h.Vals, defaults.Vals},
+		{&h.Include, defaults.Include},
+		{&h.Headers, defaults.Headers},
+		{&h.Params, defaults.Params},
+		{&h.Sync, defaults.Sync},
+		{&h.Prompt, defaults.Prompt},
+		{&h.Request, defaults.Request},
+		{&h.DisabledElt, defaults.DisabledElt},
+		{&h.SwapOOB, defaults.SwapOOB},
+		{&h.SelectOOB, defaults.SelectOOB},
+		{&h.ReplaceURL, defaults.ReplaceURL},
+		{&h.Ext, defaults.Ext},
+		{&h.Disinherit, defaults.Disinherit},
+		{&h.Inherit, defaults.Inherit},
+	} {
+		if *field.value == "" {
+			*field.value = field.fallback
+		}
+	}
+	if h.Encoding == EncodingDefault {
+		h.Encoding = defaults.Encoding
+	}
+	if h.Boost == FlagUnset {
+		h.Boost = defaults.Boost
+	}
+	if h.History == FlagUnset {
+		h.History = defaults.History
+	}
+	h.ValidateForm = h.ValidateForm || defaults.ValidateForm
+	h.Preserve = h.Preserve || defaults.Preserve
+	h.Disable = h.Disable || defaults.Disable
+	h.HistoryElt = h.HistoryElt || defaults.HistoryElt
+	if len(defaults.On) > 0 {
+		on := make(map[string]string, len(h.On)+len(defaults.On))
+		for event, handler := range defaults.On {
+			on[event] = handler
+		}
+		for event, handler := range h.On {
+			on[event] = handler
+		}
+		h.On = on
+	}
+	return h
+}
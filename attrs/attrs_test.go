@@ -0,0 +1,191 @@
+package attrs
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
+)
+
+func renderAttributes(t *testing.T, attributes templ.Attributer) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := templ.RenderAttributes(context.Background(), &buf, attributes); err != nil {
+		t.Fatalf("failed to render attributes: %v", err)
+	}
+	return buf.String()
+}
+
+func TestHtmxAttrs_RendersSetAttributesInOrder(t *testing.T) {
+	h := HtmxAttrs{
+		Vals:      `{"a":1}`,
+		Select:    "#content",
+		PushURL:   "true",
+		Indicator: "#spinner",
+		Confirm:   "Sure?",
+		Trigger:   "click",
+		Swap:      "outerHTML",
+		Target:    "#result",
+		Patch:     "/patch",
+		Delete:    "/delete",
+		Put:       "/put",
+		Post:      "/post",
+		Get:       "/get",
+	}
+
+	want := ` hx-get="/get" hx-post="/post" hx-put="/put" hx-delete="/delete" hx-patch="/patch"` +
+		` hx-target="#result" hx-swap="outerHTML" hx-trigger="click" hx-confirm="Sure?"` +
+		` hx-indicator="#spinner" hx-push-url="true" hx-select="#content" hx-vals="{&#34;a&#34;:1}"`
+	if got := renderAttributes(t, h); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestHtmxAttrs_OmitsEmptyAttributes(t *testing.T) {
+	if got := renderAttributes(t, HtmxAttrs{Target: "#result"}); got != ` hx-target="#result"` {
+		t.Errorf("expected only hx-target, got %s", got)
+	}
+	if got := renderAttributes(t, HtmxAttrs{}); got != "" {
+		t.Errorf("expected no attributes, got %s", got)
+	}
+}
+
+func TestHtmxAttrs_HasHtmxAndIsZero(t *testing.T) {
+	tests := []struct {
+		h       HtmxAttrs
+		hasHtmx bool
+		isZero  bool
+	}{
+		{HtmxAttrs{}, false, true},
+		{HtmxAttrs{Trigger: "load"}, false, false},
+		{HtmxAttrs{Delete: "/item/1"}, true, false},
+	}
+	for _, tt := range tests {
+		if got := tt.h.HasHtmx(); got != tt.hasHtmx {
+			t.Errorf("%+v: expected HasHtmx %v, got %v", tt.h, tt.hasHtmx, got)
+		}
+		if got := tt.h.IsZero(); got != tt.isZero {
+			t.Errorf("%+v: expected IsZero %v, got %v", tt.h, tt.isZero, got)
+		}
+	}
+}
+
+func TestHtmxAttrs_WithDefaults(t *testing.T) {
+	h := HtmxAttrs{Get: "/new", Swap: "innerHTML"}
+
+	got := h.WithDefaults(HtmxAttrs{Get: "/old", Target: "#result"})
+
+	if got.Get != "/new" || got.Swap != "innerHTML" || got.Target != "#result" {
+		t.Errorf("expected set fields to win over defaults, got %+v", got)
+	}
+	if h.Target != "" {
+		t.Error("expected receiver not to be modified")
+	}
+}
+
+func TestHtmxAttrs_RendersFullAttributeSet(t *testing.T) {
+	h := HtmxAttrs{
+		Include:      "closest form",
+		Headers:      `{"X-Token":"1"}`,
+		Params:       "not secret",
+		Encoding:     EncodingMultipart,
+		Sync:         "closest form:abort",
+		Prompt:       "Name?",
+		Request:      "timeout:3000",
+		ValidateForm: true,
+		DisabledElt:  "this",
+		SwapOOB:      "true",
+		SelectOOB:    "#alerts",
+		ReplaceURL:   "/page/2",
+		Preserve:     true,
+		Boost:        FlagFalse,
+		Ext:          "sse",
+		Disinherit:   "*",
+		Inherit:      "hx-target",
+		History:      FlagFalse,
+		Disable:      true,
+		HistoryElt:   true,
+		On:           map[string]string{"htmx:after-request": "done()", "click": "go()"},
+	}
+
+	want := ` hx-include="closest form" hx-headers="{&#34;X-Token&#34;:&#34;1&#34;}" hx-params="not secret"` +
+		` hx-encoding="multipart/form-data" hx-sync="closest form:abort" hx-prompt="Name?"` +
+		` hx-request="timeout:3000" hx-validate="true" hx-disabled-elt="this" hx-swap-oob="true"` +
+		` hx-select-oob="#alerts" hx-replace-url="/page/2" hx-preserve="true" hx-boost="false"` +
+		` hx-ext="sse" hx-disinherit="*" hx-inherit="hx-target" hx-history="false"` +
+		` hx-disable hx-history-elt hx-on:click="go()" hx-on:htmx:after-request="done()"`
+	if got := renderAttributes(t, h); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestHtmxAttrs_Validate(t *testing.T) {
+	valid := []HtmxAttrs{
+		{},
+		{Boost: FlagTrue, History: FlagFalse, Encoding: EncodingMultipart},
+		{On: map[string]string{"click": "", "htmx:before-request": "", ":after-swap": "", "keyup.enter": ""}},
+	}
+	for _, h := range valid {
+		if err := h.Validate(); err != nil {
+			t.Errorf("%+v: unexpected error: %v", h, err)
+		}
+	}
+	invalid := []HtmxAttrs{
+		{Boost: "yes"},
+		{History: "off"},
+		{Encoding: "application/json"},
+		{On: map[string]string{"click dblclick": "x()"}},
+		{On: map[string]string{"": "x()"}},
+	}
+	for _, h := range invalid {
+		if err := h.Validate(); err == nil {
+			t.Errorf("%+v: expected error", h)
+		}
+	}
+}
+
+func TestHtmxAttrs_Require(t *testing.T) {
+	ctx := assets.WithRegistry(context.Background())
+	var buf bytes.Buffer
+
+	if err := (HtmxAttrs{}).Require().Render(ctx, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(assets.FromContext(ctx).Pending().Assets) != 0 {
+		t.Error("expected HTMX not to be required for zero attributes")
+	}
+
+	if err := (HtmxAttrs{Boost: FlagTrue}).Require().Render(ctx, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pending := assets.FromContext(ctx).Pending().Assets; len(pending) != 1 || pending[0] != assets.HTMX {
+		t.Errorf("expected HTMX to be required, got %v", pending)
+	}
+
+	err := (HtmxAttrs{Encoding: "text/plain"}).Require().Render(ctx, &buf)
+	if err == nil || !strings.Contains(err.Error(), "hx-encoding") {
+		t.Errorf("expected encoding error, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output, got %s", buf.String())
+	}
+}
+
+func TestHtmxAttrs_WithDefaultsMergesHandlers(t *testing.T) {
+	h := HtmxAttrs{On: map[string]string{"click": "mine()"}}
+
+	got := h.WithDefaults(HtmxAttrs{Boost: FlagTrue, Preserve: true, On: map[string]string{"click": "theirs()", "load": "init()"}})
+
+	if got.On["click"] != "mine()" || got.On["load"] != "init()" {
+		t.Errorf("expected own handlers to win, got %v", got.On)
+	}
+	if got.Boost != FlagTrue || !got.Preserve {
+		t.Errorf("expected typed defaults to apply, got %+v", got)
+	}
+	if len(h.On) != 1 {
+		t.Error("expected receiver handlers not to be modified")
+	}
+}
@@ -0,0 +1,90 @@
+package attrs
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/a-h/templ"
+)
+
+// Merge combines attribute sets, such as a component's own attributes, an
+// HtmxAttrs value and a caller's Attrs, into one map:
+//
+//	{ attrs.Merge(templ.Attributes{"class": "card"}, props.Htmx, props.Attrs)... }
+//
+// Later sets take precedence over earlier ones, with two exceptions. String
+// class values are concatenated in order, dropping repeated class names. A
+// false bool removes the attribute set by an earlier set. Nil sets are
+// skipped. Use Duplicates to find the keys a later set overrides.
+func Merge(sets ...templ.Attributer) templ.Attributes {
+	merged := templ.Attributes{}
+	var classes []string
+	for _, set := range sets {
+		if set == nil {
+			continue
+		}
+		for _, item := range set.Items() {
+			if item.Key == "class" {
+				if class, ok := item.Value.(string); ok {
+					classes = appendClasses(classes, class)
+					continue
+				}
+				classes = nil
+			}
+			if value, ok := item.Value.(bool); ok && !value {
+				delete(merged, item.Key)
+				continue
+			}
+			merged[item.Key] = item.Value
+		}
+	}
+	if len(classes) > 0 {
+		merged["class"] = strings.Join(classes, " ")
+	}
+	return merged
+}
+
+// appendClasses appends the space separated class names in class to classes,
+// skipping names already present.
+func appendClasses(classes []string, class string) []string {
+	for _, name := range strings.Fields(class) {
+		if !contains(classes, name) {
+			classes = append(classes, name)
+		}
+	}
+	return classes
+}
+
+// contains reports whether items contains item.
+func contains(items []string, item string) bool {
+	for _, existing := range items {
+		if existing == item {
+			return true
+		}
+	}
+	return false
+}
+
+// Duplicates returns, in alphabetical order, the keys other than class that
+// more than one set assigns, which Merge resolves in favour of the last set.
+// Components can use it to reject callers overriding attributes they manage.
+func Duplicates(sets ...templ.Attributer) []string {
+	seen := map[string]bool{}
+	var duplicates []string
+	for _, set := range sets {
+		if set == nil {
+			continue
+		}
+		for _, item := range set.Items() {
+			if item.Key == "class" {
+				continue
+			}
+			if seen[item.Key] && !contains(duplicates, item.Key) {
+				duplicates = append(duplicates, item.Key)
+			}
+			seen[item.Key] = true
+		}
+	}
+	sort.Strings(duplicates)
+	return duplicates
+}
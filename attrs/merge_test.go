@@ -0,0 +1,92 @@
+package attrs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestHtmxAttrs_Attributes(t *testing.T) {
+	got := HtmxAttrs{Get: "/items", Target: "#list", Disable: true, On: map[string]string{"click": "go()"}}.Attributes()
+
+	want := templ.Attributes{"hx-get": "/items", "hx-target": "#list", "hx-disable": true, "hx-on:click": "go()"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := (HtmxAttrs{}).Attributes(); len(got) != 0 {
+		t.Errorf("expected no attributes, got %v", got)
+	}
+}
+
+func TestMerge_LaterSetsTakePrecedence(t *testing.T) {
+	got := Merge(
+		templ.Attributes{"role": "button", "hx-target": "this"},
+		HtmxAttrs{Get: "/items", Target: "#list"},
+		templ.Attributes{"hx-get": "/other", "data-id": "7"},
+	)
+
+	want := templ.Attributes{"role": "button", "hx-target": "#list", "hx-get": "/other", "data-id": "7"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestMerge_ConcatenatesClasses(t *testing.T) {
+	got := Merge(
+		templ.Attributes{"class": "card outline"},
+		nil,
+		templ.Attributes{"class": " wide  card "},
+		templ.Attributes{"class": ""},
+	)
+
+	if got["class"] != "card outline wide" {
+		t.Errorf("expected concatenated classes without repeats, got %q", got["class"])
+	}
+}
+
+func TestMerge_FalseRemovesAttribute(t *testing.T) {
+	got := Merge(
+		templ.Attributes{"disabled": true, "hidden": true},
+		templ.Attributes{"disabled": false},
+	)
+
+	if _, ok := got["disabled"]; ok {
+		t.Errorf("expected disabled to be removed, got %v", got)
+	}
+	if got["hidden"] != true {
+		t.Errorf("expected hidden to be kept, got %v", got)
+	}
+}
+
+func TestMerge_RendersSortedAttributes(t *testing.T) {
+	got := renderAttributes(t, Merge(HtmxAttrs{Post: "/save", Swap: "none"}, templ.Attributes{"class": "a", "id": "x"}))
+
+	if want := ` class="a" hx-post="/save" hx-swap="none" id="x"`; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestMerge_DoesNotModifyInputs(t *testing.T) {
+	base := templ.Attributes{"class": "a", "id": "x"}
+	Merge(base, templ.Attributes{"class": "b", "id": "y"})
+
+	if base["class"] != "a" || base["id"] != "x" {
+		t.Errorf("expected inputs to be unchanged, got %v", base)
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	got := Duplicates(
+		templ.Attributes{"class": "a", "id": "x", "hx-get": "/a"},
+		HtmxAttrs{Get: "/b", Swap: "none"},
+		templ.Attributes{"class": "b", "id": "y", "hx-get": "/c"},
+	)
+
+	if want := []string{"hx-get", "id"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := Duplicates(templ.Attributes{"id": "x"}, HtmxAttrs{Get: "/a"}); len(got) != 0 {
+		t.Errorf("expected no duplicates, got %v", got)
+	}
+}
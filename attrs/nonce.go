@@ -0,0 +1,17 @@
+package attrs
+
+import (
+	"context"
+
+	"github.com/a-h/templ"
+)
+
+// Nonce returns a nonce attribute carrying the CSP nonce set on ctx with
+// templ.WithNonce (or head.WithNonce), or no attributes when none is set.
+// Spread it into inline <script> and <style> elements.
+func Nonce(ctx context.Context) templ.Attributes {
+	if nonce := templ.GetNonce(ctx); nonce != "" {
+		return templ.Attributes{"nonce": nonce}
+	}
+	return templ.Attributes{}
+}
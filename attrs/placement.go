@@ -0,0 +1,63 @@
+package attrs
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/a-h/templ"
+)
+
+// Placement is the side of an element on which a Pico CSS tooltip is shown.
+type Placement string
+
+// Tooltip placements supported by Pico CSS data-placement.
+const (
+	PlacementDefault Placement = ""       // Pico default (top)
+	PlacementTop     Placement = "top"    // Above the element
+	PlacementRight   Placement = "right"  // Right of the element
+	PlacementBottom  Placement = "bottom" // Below the element
+	PlacementLeft    Placement = "left"   // Left of the element
+)
+
+// Validate returns an error if p is not a supported placement.
+func (p Placement) Validate() error {
+	switch p {
+	case PlacementDefault, PlacementTop, PlacementRight, PlacementBottom, PlacementLeft:
+		return nil
+	}
+	return fmt.Errorf("attrs: invalid tooltip placement %q", string(p))
+}
+
+// Value returns the data-placement attribute value, or an error if p is invalid.
+func (p Placement) Value() (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+	return string(p), nil
+}
+
+// Check returns a component that renders nothing and fails the render if p
+// is invalid. Render it before an element spreading Tooltip:
+//
+//	@props.TooltipPlacement.Check()
+//	<small { attrs.Tooltip(props.Tooltip, props.TooltipPlacement)... }>
+func (p Placement) Check() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return p.Validate()
+	})
+}
+
+// Tooltip returns the data-tooltip and data-placement attributes for a Pico
+// CSS tooltip, or no attributes if text is empty. The placement is not
+// validated; render p.Check for that.
+func Tooltip(text string, p Placement) templ.Attributes {
+	if text == "" {
+		return nil
+	}
+	attributes := templ.Attributes{"data-tooltip": text}
+	if p != PlacementDefault {
+		attributes["data-placement"] = string(p)
+	}
+	return attributes
+}
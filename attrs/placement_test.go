@@ -0,0 +1,37 @@
+package attrs
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func TestTooltip(t *testing.T) {
+	tests := []struct {
+		text      string
+		placement Placement
+		want      string
+	}{
+		{"", PlacementLeft, ""},
+		{"Saves the draft", PlacementDefault, ` data-tooltip="Saves the draft"`},
+		{"Saves the draft", PlacementBottom, ` data-placement="bottom" data-tooltip="Saves the draft"`},
+	}
+	for _, tt := range tests {
+		if got := renderAttributes(t, Tooltip(tt.text, tt.placement)); got != tt.want {
+			t.Errorf("%q, %q: expected %s, got %s", tt.text, tt.placement, tt.want, got)
+		}
+	}
+}
+
+func TestPlacement_Check(t *testing.T) {
+	var buf bytes.Buffer
+	if err := PlacementRight.Check().Render(context.Background(), &buf); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := Placement("diagonal").Check().Render(context.Background(), &buf); err == nil {
+		t.Error("expected error for invalid placement")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output, got %s", buf.String())
+	}
+}
@@ -0,0 +1,239 @@
+package attrs
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SwapStrategy is how HTMX swaps response content into the target.
+type SwapStrategy string
+
+// Swap strategies supported by htmx.
+const (
+	SwapDefault     SwapStrategy = ""            // htmx default (innerHTML)
+	SwapInnerHTML   SwapStrategy = "innerHTML"   // Replace the target's content
+	SwapOuterHTML   SwapStrategy = "outerHTML"   // Replace the target element
+	SwapTextContent SwapStrategy = "textContent" // Replace the target's text, without parsing HTML
+	SwapBeforeBegin SwapStrategy = "beforebegin" // Insert before the target
+	SwapAfterBegin  SwapStrategy = "afterbegin"  // Insert before the target's first child
+	SwapBeforeEnd   SwapStrategy = "beforeend"   // Insert after the target's last child
+	SwapAfterEnd    SwapStrategy = "afterend"    // Insert after the target
+	SwapDelete      SwapStrategy = "delete"      // Delete the target
+	SwapNone        SwapStrategy = "none"        // Do not swap (out of band swaps still happen)
+)
+
+// Validate returns an error if s is not a supported swap strategy.
+func (s SwapStrategy) Validate() error {
+	switch s {
+	case SwapDefault, SwapInnerHTML, SwapOuterHTML, SwapTextContent, SwapBeforeBegin,
+		SwapAfterBegin, SwapBeforeEnd, SwapAfterEnd, SwapDelete, SwapNone:
+		return nil
+	}
+	return fmt.Errorf("attrs: invalid swap strategy %q", string(s))
+}
+
+// Position is where the swap scroll and show modifiers move the viewport.
+type Position string
+
+// Positions for Swap.Scroll and Swap.Show.
+const (
+	PositionDefault Position = ""       // No scrolling
+	PositionTop     Position = "top"    // Top of the element
+	PositionBottom  Position = "bottom" // Bottom of the element
+	PositionNone    Position = "none"   // Disable showing (Show only)
+)
+
+// Swap builds an hx-swap value. The zero value is the htmx default. Custom
+// swap styles from extensions, such as idiomorph's morph, are not supported;
+// set hx-swap through Attrs for those.
+type Swap struct {
+	Strategy     SwapStrategy  // How to swap (default innerHTML)
+	Transition   bool          // Use the View Transitions API (transition:true)
+	Delay        time.Duration // Wait before swapping (swap:<time>)
+	Settle       time.Duration // Wait between swapping and settling (settle:<time>)
+	IgnoreTitle  bool          // Ignore a title in the response (ignoreTitle:true)
+	Scroll       Position      // Scroll the target, or ScrollTarget, to the top or bottom
+	ScrollTarget string        // Selector or "window" to scroll instead of the target
+	Show         Position      // Scroll the target, or ShowTarget, into view at the top or bottom
+	ShowTarget   string        // Selector or "window" to show instead of the target
+	FocusScroll  Flag          // Scroll focused inputs into view (focus-scroll)
+}
+
+// String returns the hx-swap value in htmx syntax, without validating it.
+func (s Swap) String() string {
+	var parts []string
+	if s.Strategy != SwapDefault {
+		parts = append(parts, string(s.Strategy))
+	}
+	if s.Transition {
+		parts = append(parts, "transition:true")
+	}
+	if s.Delay != 0 {
+		parts = append(parts, "swap:"+formatInterval(s.Delay))
+	}
+	if s.Settle != 0 {
+		parts = append(parts, "settle:"+formatInterval(s.Settle))
+	}
+	if s.IgnoreTitle {
+		parts = append(parts, "ignoreTitle:true")
+	}
+	if s.Scroll != PositionDefault {
+		parts = append(parts, "scroll:"+scrollValue(s.ScrollTarget, s.Scroll))
+	}
+	if s.Show != PositionDefault {
+		parts = append(parts, "show:"+scrollValue(s.ShowTarget, s.Show))
+	}
+	if s.FocusScroll != FlagUnset {
+		parts = append(parts, "focus-scroll:"+string(s.FocusScroll))
+	}
+	return strings.Join(parts, " ")
+}
+
+// scrollValue returns a scroll or show modifier value.
+func scrollValue(target string, position Position) string {
+	if target == "" {
+		return string(position)
+	}
+	return target + ":" + string(position)
+}
+
+// Validate returns an error if s does not describe a valid hx-swap value.
+func (s Swap) Validate() error {
+	if err := s.Strategy.Validate(); err != nil {
+		return err
+	}
+	if s.Delay < 0 || s.Settle < 0 {
+		return fmt.Errorf("attrs: swap and settle delays must not be negative")
+	}
+	if err := validateInterval(s.Delay); err != nil {
+		return err
+	}
+	if err := validateInterval(s.Settle); err != nil {
+		return err
+	}
+	if err := validateScroll("scroll", s.ScrollTarget, s.Scroll, false); err != nil {
+		return err
+	}
+	if err := validateScroll("show", s.ShowTarget, s.Show, true); err != nil {
+		return err
+	}
+	if err := s.FocusScroll.Validate(); err != nil {
+		return fmt.Errorf("attrs: invalid focus-scroll: %w", err)
+	}
+	return nil
+}
+
+// validateScroll checks a scroll or show modifier.
+func validateScroll(modifier, target string, position Position, allowNone bool) error {
+	switch {
+	case position == PositionDefault && target != "":
+		return fmt.Errorf("attrs: %s target %q needs a position", modifier, target)
+	case position == PositionNone && (!allowNone || target != ""):
+		return fmt.Errorf("attrs: invalid %s position %q", modifier, string(position))
+	case position != PositionDefault && position != PositionTop && position != PositionBottom && position != PositionNone:
+		return fmt.Errorf("attrs: invalid %s position %q", modifier, string(position))
+	case strings.ContainsAny(target, " \t\n"):
+		return fmt.Errorf("attrs: %s target %q must not contain whitespace", modifier, target)
+	}
+	return nil
+}
+
+// Value returns the hx-swap value, or an error if s is invalid.
+func (s Swap) Value() (string, error) {
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+	return s.String(), nil
+}
+
+// ParseSwap parses an hx-swap value, returning an error if it does not follow
+// the htmx grammar.
+func ParseSwap(value string) (Swap, error) {
+	var s Swap
+	for i, token := range strings.Fields(value) {
+		name, arg, hasArg := strings.Cut(token, ":")
+		var err error
+		switch {
+		case hasArg && name == "transition":
+			s.Transition, err = parseBool(token, arg)
+		case hasArg && name == "swap":
+			s.Delay, err = parseInterval(arg)
+		case hasArg && name == "settle":
+			s.Settle, err = parseInterval(arg)
+		case hasArg && name == "ignoreTitle":
+			s.IgnoreTitle, err = parseBool(token, arg)
+		case hasArg && name == "scroll":
+			s.ScrollTarget, s.Scroll = parseScroll(arg)
+		case hasArg && name == "show":
+			s.ShowTarget, s.Show = parseScroll(arg)
+		case hasArg && name == "focus-scroll":
+			s.FocusScroll = Flag(arg)
+		case i == 0:
+			s.Strategy = SwapStrategy(token)
+		default:
+			err = fmt.Errorf("attrs: unknown hx-swap modifier %q", token)
+		}
+		if err != nil {
+			return Swap{}, err
+		}
+	}
+	if err := s.Validate(); err != nil {
+		return Swap{}, err
+	}
+	return s, nil
+}
+
+// parseScroll splits a scroll or show modifier value into target and position.
+func parseScroll(value string) (string, Position) {
+	if i := strings.LastIndex(value, ":"); i >= 0 {
+		return value[:i], Position(value[i+1:])
+	}
+	return "", Position(value)
+}
+
+// parseBool parses the true or false argument of a modifier.
+func parseBool(token, value string) (bool, error) {
+	switch value {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, fmt.Errorf("attrs: invalid boolean in %q", token)
+}
+
+// interval matches an htmx time interval, e.g. 500ms, 1s, 1.5s or 2m.
+var interval = regexp.MustCompile(`^(\d+(?:\.\d+)?)(ms|s|m)?$`)
+
+// parseInterval parses an htmx time interval. A bare number is milliseconds.
+func parseInterval(value string) (time.Duration, error) {
+	match := interval.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("attrs: invalid time interval %q", value)
+	}
+	n, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("attrs: invalid time interval %q", value)
+	}
+	unit := map[string]time.Duration{"": time.Millisecond, "ms": time.Millisecond, "s": time.Second, "m": time.Minute}[match[2]]
+	return time.Duration(n * float64(unit)), nil
+}
+
+// formatInterval formats d as an htmx time interval, in seconds when whole.
+func formatInterval(d time.Duration) string {
+	if d%time.Second == 0 {
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	}
+	return strconv.FormatInt(d.Milliseconds(), 10) + "ms"
+}
+
+// validateInterval returns an error if d cannot be expressed in milliseconds.
+func validateInterval(d time.Duration) error {
+	if d%time.Millisecond != 0 {
+		return fmt.Errorf("attrs: time interval %s is not a whole number of milliseconds", d)
+	}
+	return nil
+}
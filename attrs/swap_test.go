@@ -0,0 +1,95 @@
+package attrs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSwap_String(t *testing.T) {
+	tests := []struct {
+		swap Swap
+		want string
+	}{
+		{Swap{}, ""},
+		{Swap{Strategy: SwapOuterHTML}, "outerHTML"},
+		{Swap{Strategy: SwapOuterHTML, Settle: 100 * time.Millisecond, Scroll: PositionTop, Transition: true}, "outerHTML transition:true settle:100ms scroll:top"},
+		{Swap{Strategy: SwapBeforeEnd, Delay: time.Second, IgnoreTitle: true}, "beforeend swap:1s ignoreTitle:true"},
+		{Swap{Show: PositionBottom, ShowTarget: "#messages"}, "show:#messages:bottom"},
+		{Swap{Strategy: SwapInnerHTML, Show: PositionTop, ShowTarget: "window", FocusScroll: FlagFalse}, "innerHTML show:window:top focus-scroll:false"},
+		{Swap{Show: PositionNone}, "show:none"},
+		{Swap{Delay: 1500 * time.Millisecond}, "swap:1500ms"},
+	}
+	for _, tt := range tests {
+		got, err := tt.swap.Value()
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", tt.swap, err)
+		}
+		if got != tt.want {
+			t.Errorf("expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestSwap_ValidateRejectsInvalidCombinations(t *testing.T) {
+	invalid := []Swap{
+		{Strategy: "innerHtml"},
+		{Strategy: "morph"},
+		{Delay: -time.Second},
+		{Settle: 1500 * time.Microsecond},
+		{ScrollTarget: "#list"},
+		{Scroll: "middle"},
+		{Scroll: PositionNone},
+		{Show: PositionNone, ShowTarget: "#list"},
+		{Show: PositionTop, ShowTarget: "#a #b"},
+		{FocusScroll: "yes"},
+	}
+	for _, s := range invalid {
+		if _, err := s.Value(); err == nil {
+			t.Errorf("%+v: expected error", s)
+		}
+	}
+}
+
+func TestParseSwap(t *testing.T) {
+	valid := map[string]Swap{
+		"innerHTML":                       {Strategy: SwapInnerHTML},
+		"outerHTML settle:100ms":          {Strategy: SwapOuterHTML, Settle: 100 * time.Millisecond},
+		"afterend swap:0.5s":              {Strategy: SwapAfterEnd, Delay: 500 * time.Millisecond},
+		"beforeend scroll:bottom":         {Strategy: SwapBeforeEnd, Scroll: PositionBottom},
+		"innerHTML show:#results:top":     {Strategy: SwapInnerHTML, Show: PositionTop, ShowTarget: "#results"},
+		"transition:true":                 {Transition: true},
+		"none ignoreTitle:true":           {Strategy: SwapNone, IgnoreTitle: true},
+		"textContent focus-scroll:true":   {Strategy: SwapTextContent, FocusScroll: FlagTrue},
+		"delete swap:200":                 {Strategy: SwapDelete, Delay: 200 * time.Millisecond},
+		"outerHTML show:window:top":       {Strategy: SwapOuterHTML, Show: PositionTop, ShowTarget: "window"},
+		"afterbegin  scroll:#log:bottom ": {Strategy: SwapAfterBegin, Scroll: PositionBottom, ScrollTarget: "#log"},
+	}
+	for value, want := range valid {
+		got, err := ParseSwap(value)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", value, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("%q: expected %+v, got %+v", value, want, got)
+		}
+		if round, err := ParseSwap(got.String()); err != nil || round != got {
+			t.Errorf("%q: expected %q to round trip, got %+v (%v)", value, got.String(), round, err)
+		}
+	}
+
+	invalid := []string{
+		"innerHtml",
+		"outerHTML settle:1sec",
+		"outerHTML swap:fast",
+		"outerHTML transition:yes",
+		"outerHTML scroll:middle",
+		"outerHTML outerHTML",
+		"outerHTML delay:1s",
+	}
+	for _, value := range invalid {
+		if _, err := ParseSwap(value); err == nil {
+			t.Errorf("%q: expected error", value)
+		}
+	}
+}
@@ -0,0 +1,357 @@
+package attrs
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Queue is how a trigger queues events that fire while a request is in flight.
+type Queue string
+
+// Queue strategies for Trigger.Queue.
+const (
+	QueueDefault Queue = ""      // htmx default (last)
+	QueueFirst   Queue = "first" // Queue the first event
+	QueueLast    Queue = "last"  // Queue the last event
+	QueueAll     Queue = "all"   // Queue every event
+	QueueNone    Queue = "none"  // Do not queue events
+)
+
+// Validate returns an error if q is not a supported queue strategy.
+func (q Queue) Validate() error {
+	switch q {
+	case QueueDefault, QueueFirst, QueueLast, QueueAll, QueueNone:
+		return nil
+	}
+	return fmt.Errorf("attrs: invalid trigger queue %q", string(q))
+}
+
+// Trigger builds one hx-trigger entry: an event with modifiers, or polling
+// with Every.
+type Trigger struct {
+	Event     string        // Event name, e.g. click, keyup, load, revealed, intersect or htmx:afterSwap
+	Every     time.Duration // Poll at this interval instead of listening for Event
+	Filter    string        // JavaScript filter expression, rendered in brackets
+	Once      bool          // Trigger only once
+	Changed   bool          // Trigger only if the element's value changed
+	Delay     time.Duration // Wait for events to stop for this long before triggering
+	Throttle  time.Duration // Trigger at most once per interval
+	From      string        // Extended selector to listen on, e.g. document or "closest form"
+	Target    string        // Only trigger for events whose target matches this selector
+	Consume   bool          // Stop the event from triggering requests on parent elements
+	Queue     Queue         // How to queue events during a request
+	Root      string        // Root element selector (intersect only)
+	Threshold float64       // Visible fraction between 0 and 1 (intersect only)
+}
+
+// fromKeywords are extended selector prefixes that take a selector argument.
+var fromKeywords = map[string]bool{"closest": true, "find": true, "next": true, "previous": true}
+
+// String returns the trigger in htmx syntax, without validating it.
+func (t Trigger) String() string {
+	var parts []string
+	if t.Every != 0 {
+		parts = append(parts, "every", formatInterval(t.Every))
+	} else {
+		parts = append(parts, t.Event)
+	}
+	if t.Filter != "" && t.Every != 0 {
+		parts = append(parts, "["+t.Filter+"]")
+	} else if t.Filter != "" {
+		parts[0] += "[" + t.Filter + "]"
+	}
+	if t.Once {
+		parts = append(parts, "once")
+	}
+	if t.Changed {
+		parts = append(parts, "changed")
+	}
+	if t.Delay != 0 {
+		parts = append(parts, "delay:"+formatInterval(t.Delay))
+	}
+	if t.Throttle != 0 {
+		parts = append(parts, "throttle:"+formatInterval(t.Throttle))
+	}
+	if t.From != "" {
+		parts = append(parts, "from:"+t.From)
+	}
+	if t.Target != "" {
+		parts = append(parts, "target:"+t.Target)
+	}
+	if t.Consume {
+		parts = append(parts, "consume")
+	}
+	if t.Queue != QueueDefault {
+		parts = append(parts, "queue:"+string(t.Queue))
+	}
+	if t.Root != "" {
+		parts = append(parts, "root:"+t.Root)
+	}
+	if t.Threshold != 0 {
+		parts = append(parts, "threshold:"+strconv.FormatFloat(t.Threshold, 'f', -1, 64))
+	}
+	return strings.Join(parts, " ")
+}
+
+// eventPattern matches event names, including namespaced ones such as
+// htmx:afterSwap and sse:message.
+var eventPattern = regexp.MustCompile(`^[A-Za-z_][\w.:-]*$`)
+
+// Validate returns an error if t does not describe a valid trigger.
+func (t Trigger) Validate() error {
+	if t.Every != 0 {
+		if t.Event != "" {
+			return fmt.Errorf("attrs: trigger sets both Every and Event %q", t.Event)
+		}
+		if t.Every < 0 {
+			return fmt.Errorf("attrs: trigger interval must be positive")
+		}
+		if t.Once || t.Changed || t.Delay != 0 || t.Throttle != 0 || t.From != "" || t.Target != "" ||
+			t.Consume || t.Queue != QueueDefault || t.Root != "" || t.Threshold != 0 {
+			return fmt.Errorf("attrs: polling triggers only accept a filter")
+		}
+		if err := validateInterval(t.Every); err != nil {
+			return err
+		}
+	} else if !eventPattern.MatchString(t.Event) {
+		return fmt.Errorf("attrs: invalid trigger event %q", t.Event)
+	}
+	if !balanced(t.Filter) {
+		return fmt.Errorf("attrs: unbalanced brackets in trigger filter %q", t.Filter)
+	}
+	if t.Delay < 0 || t.Throttle < 0 {
+		return fmt.Errorf("attrs: trigger delay and throttle must not be negative")
+	}
+	if t.Delay != 0 && t.Throttle != 0 {
+		return fmt.Errorf("attrs: trigger sets both delay and throttle")
+	}
+	if err := validateInterval(t.Delay); err != nil {
+		return err
+	}
+	if err := validateInterval(t.Throttle); err != nil {
+		return err
+	}
+	if err := validateFrom(t.From); err != nil {
+		return err
+	}
+	if strings.ContainsAny(t.Target, " \t\n") {
+		return fmt.Errorf("attrs: trigger target %q must not contain whitespace", t.Target)
+	}
+	if err := t.Queue.Validate(); err != nil {
+		return err
+	}
+	if (t.Root != "" || t.Threshold != 0) && t.Event != "intersect" {
+		return fmt.Errorf("attrs: root and threshold only apply to intersect triggers")
+	}
+	if strings.ContainsAny(t.Root, " \t\n") {
+		return fmt.Errorf("attrs: trigger root %q must not contain whitespace", t.Root)
+	}
+	if t.Threshold < 0 || t.Threshold > 1 {
+		return fmt.Errorf("attrs: trigger threshold %v is not between 0 and 1", t.Threshold)
+	}
+	return nil
+}
+
+// validateFrom checks a from: extended selector, which may only contain a
+// space after closest, find, next or previous. closest and find need a
+// selector.
+func validateFrom(from string) error {
+	keyword, selector, spaced := strings.Cut(from, " ")
+	if spaced && (!fromKeywords[keyword] || selector == "" || strings.ContainsAny(selector, " \t\n")) ||
+		from == "closest" || from == "find" {
+		return fmt.Errorf("attrs: invalid trigger from selector %q", from)
+	}
+	return nil
+}
+
+// balanced reports whether the square brackets in s are balanced.
+func balanced(s string) bool {
+	depth := 0
+	for _, r := range s {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
+
+// Value returns the trigger in htmx syntax, or an error if t is invalid.
+func (t Trigger) Value() (string, error) {
+	if err := t.Validate(); err != nil {
+		return "", err
+	}
+	return t.String(), nil
+}
+
+// Triggers builds an hx-trigger value listing several triggers.
+type Triggers []Trigger
+
+// String returns the triggers in htmx syntax, without validating them.
+func (ts Triggers) String() string {
+	parts := make([]string, len(ts))
+	for i, t := range ts {
+		parts[i] = t.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Validate returns an error if any trigger is invalid.
+func (ts Triggers) Validate() error {
+	for _, t := range ts {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Value returns the triggers in htmx syntax, or an error if any is invalid.
+func (ts Triggers) Value() (string, error) {
+	if err := ts.Validate(); err != nil {
+		return "", err
+	}
+	return ts.String(), nil
+}
+
+// ParseTriggers parses an hx-trigger value, returning an error if it does not
+// follow the htmx grammar.
+func ParseTriggers(value string) (Triggers, error) {
+	var ts Triggers
+	for _, entry := range splitOutsideBrackets(value, ',') {
+		t, err := parseTrigger(entry)
+		if err != nil {
+			return nil, err
+		}
+		ts = append(ts, t)
+	}
+	if len(ts) == 0 {
+		return nil, fmt.Errorf("attrs: empty hx-trigger")
+	}
+	return ts, nil
+}
+
+// parseTrigger parses a single hx-trigger entry.
+func parseTrigger(entry string) (Trigger, error) {
+	var t Trigger
+	tokens := splitOutsideBrackets(entry, ' ')
+	if len(tokens) == 0 {
+		return t, fmt.Errorf("attrs: empty trigger in hx-trigger")
+	}
+	head, rest := tokens[0], tokens[1:]
+	if head == "every" {
+		if len(rest) == 0 {
+			return t, fmt.Errorf("attrs: polling trigger %q needs an interval", entry)
+		}
+		head, rest = rest[0], rest[1:]
+		every, filter := cutFilter(head)
+		var err error
+		if t.Every, err = parseInterval(every); err != nil {
+			return t, err
+		}
+		if t.Every == 0 {
+			return t, fmt.Errorf("attrs: polling trigger %q needs a positive interval", entry)
+		}
+		t.Filter = filter
+	} else {
+		t.Event, t.Filter = cutFilter(head)
+	}
+	if len(rest) > 0 && strings.HasPrefix(rest[0], "[") && t.Filter == "" {
+		t.Filter, _ = strings.CutSuffix(strings.TrimPrefix(rest[0], "["), "]")
+		rest = rest[1:]
+	}
+	for i := 0; i < len(rest); i++ {
+		token := rest[i]
+		name, arg, hasArg := strings.Cut(token, ":")
+		var err error
+		switch {
+		case token == "once":
+			t.Once = true
+		case token == "changed":
+			t.Changed = true
+		case token == "consume":
+			t.Consume = true
+		case hasArg && name == "delay":
+			t.Delay, err = parseInterval(arg)
+		case hasArg && name == "throttle":
+			t.Throttle, err = parseInterval(arg)
+		case hasArg && name == "from":
+			t.From = arg
+			if fromKeywords[arg] && i+1 < len(rest) && !isTriggerModifier(rest[i+1]) {
+				i++
+				t.From += " " + rest[i]
+			}
+		case hasArg && name == "target":
+			t.Target = arg
+		case hasArg && name == "queue":
+			t.Queue = Queue(arg)
+		case hasArg && name == "root":
+			t.Root = arg
+		case hasArg && name == "threshold":
+			t.Threshold, err = strconv.ParseFloat(arg, 64)
+		default:
+			err = fmt.Errorf("attrs: unknown hx-trigger modifier %q", token)
+		}
+		if err != nil {
+			return t, err
+		}
+	}
+	if err := t.Validate(); err != nil {
+		return t, err
+	}
+	return t, nil
+}
+
+// isTriggerModifier reports whether token is a trigger modifier.
+func isTriggerModifier(token string) bool {
+	switch name, _, _ := strings.Cut(token, ":"); name {
+	case "once", "changed", "consume", "delay", "throttle", "from", "target", "queue", "root", "threshold":
+		return true
+	}
+	return false
+}
+
+// cutFilter splits a token such as click[ctrlKey] into event and filter.
+func cutFilter(token string) (string, string) {
+	i := strings.Index(token, "[")
+	if i < 0 || !strings.HasSuffix(token, "]") {
+		return token, ""
+	}
+	return token[:i], token[i+1 : len(token)-1]
+}
+
+// splitOutsideBrackets splits s on sep outside square brackets, trimming
+// whitespace and dropping empty parts.
+func splitOutsideBrackets(s string, sep rune) []string {
+	var parts []string
+	var current strings.Builder
+	depth := 0
+	flush := func() {
+		if part := strings.TrimSpace(current.String()); part != "" {
+			parts = append(parts, part)
+		}
+		current.Reset()
+	}
+	for _, r := range s {
+		switch {
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		case depth == 0 && (r == sep || sep == ' ' && (r == '\t' || r == '\n')):
+			flush()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	flush()
+	return parts
+}
@@ -0,0 +1,132 @@
+package attrs
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTriggers_String(t *testing.T) {
+	tests := []struct {
+		triggers Triggers
+		want     string
+	}{
+		{Triggers{{Event: "click"}}, "click"},
+		{Triggers{{Event: "keyup", Changed: true, Delay: 500 * time.Millisecond}}, "keyup changed delay:500ms"},
+		{Triggers{{Every: 2 * time.Second}}, "every 2s"},
+		{Triggers{{Every: time.Second, Filter: "isActive()"}}, "every 1s [isActive()]"},
+		{Triggers{{Event: "click", Filter: "ctrlKey", Once: true}}, "click[ctrlKey] once"},
+		{Triggers{{Event: "input", Throttle: time.Second, From: "closest form", Target: "input", Consume: true, Queue: QueueAll}}, "input throttle:1s from:closest form target:input consume queue:all"},
+		{Triggers{{Event: "intersect", Once: true, Root: "#feed", Threshold: 0.5}}, "intersect once root:#feed threshold:0.5"},
+		{Triggers{{Event: "load"}, {Event: "search", From: "body"}}, "load, search from:body"},
+	}
+	for _, tt := range tests {
+		got, err := tt.triggers.Value()
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", tt.triggers, err)
+		}
+		if got != tt.want {
+			t.Errorf("expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestTrigger_ValidateRejectsInvalidCombinations(t *testing.T) {
+	invalid := []Trigger{
+		{},
+		{Event: "click dblclick"},
+		{Event: "load", Every: time.Second},
+		{Every: time.Second, Once: true},
+		{Every: -time.Second},
+		{Event: "keyup", Delay: time.Second, Throttle: time.Second},
+		{Event: "keyup", Delay: -time.Millisecond},
+		{Event: "click", Filter: "a]["},
+		{Event: "click", From: "body main"},
+		{Event: "click", From: "closest"},
+		{Event: "click", Target: "a b"},
+		{Event: "click", Queue: "latest"},
+		{Event: "revealed", Threshold: 0.5},
+		{Event: "intersect", Threshold: 2},
+	}
+	for _, tr := range invalid {
+		if _, err := tr.Value(); err == nil {
+			t.Errorf("%+v: expected error", tr)
+		}
+	}
+}
+
+func TestParseTriggers(t *testing.T) {
+	valid := map[string]Triggers{
+		"click":                                 {{Event: "click"}},
+		"every 2s":                              {{Every: 2 * time.Second}},
+		"every 1s [someConditional == true]":    {{Every: time.Second, Filter: "someConditional == true"}},
+		"keyup changed delay:500ms":             {{Event: "keyup", Changed: true, Delay: 500 * time.Millisecond}},
+		"click[ctrlKey&&shiftKey]":              {{Event: "click", Filter: "ctrlKey&&shiftKey"}},
+		"keyup[key=='Enter'], load":             {{Event: "keyup", Filter: "key=='Enter'"}, {Event: "load"}},
+		"click from:closest form once":          {{Event: "click", From: "closest form", Once: true}},
+		"click from:next":                       {{Event: "click", From: "next"}},
+		"htmx:afterSwap from:body":              {{Event: "htmx:afterSwap", From: "body"}},
+		"sse:message":                           {{Event: "sse:message"}},
+		"input throttle:1s queue:first consume": {{Event: "input", Throttle: time.Second, Queue: QueueFirst, Consume: true}},
+		"intersect root:#list threshold:0.25":   {{Event: "intersect", Root: "#list", Threshold: 0.25}},
+		"click target:.item":                    {{Event: "click", Target: ".item"}},
+		"revealed":                              {{Event: "revealed"}},
+	}
+	for value, want := range valid {
+		got, err := ParseTriggers(value)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", value, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%q: expected %+v, got %+v", value, want, got)
+		}
+		if round, err := ParseTriggers(got.String()); err != nil || !reflect.DeepEqual(round, got) {
+			t.Errorf("%q: expected %q to round trip, got %+v (%v)", value, got.String(), round, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"every 2sec",
+		"every",
+		"every 0s",
+		"click delay:fast",
+		"click onse",
+		"click queue:latest",
+		"every 1s once",
+		"keyup delay:1s throttle:1s",
+		"click[ctrlKey",
+		"load threshold:0.5",
+	}
+	for _, value := range invalid {
+		if _, err := ParseTriggers(value); err == nil {
+			t.Errorf("%q: expected error", value)
+		}
+	}
+}
+
+func TestHtmxAttrs_ValidatePassesSwapAndTriggerThrough(t *testing.T) {
+	h := HtmxAttrs{Swap: "morph", Trigger: "keyup delay:1s throttle:1s"}
+	if err := h.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := h.ValidateStrict(); err == nil {
+		t.Error("expected strict validation error")
+	}
+}
+
+func TestHtmxAttrs_ValidateStrict(t *testing.T) {
+	if err := (HtmxAttrs{Swap: "outerHTML settle:100ms", Trigger: "keyup changed delay:300ms"}).ValidateStrict(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := (HtmxAttrs{Swap: "innerHtml"}).ValidateStrict(); err == nil {
+		t.Error("expected invalid swap error")
+	}
+	if err := (HtmxAttrs{Trigger: "every 2sec"}).ValidateStrict(); err == nil {
+		t.Error("expected invalid trigger error")
+	}
+	if err := (HtmxAttrs{Boost: "yes"}).ValidateStrict(); err == nil {
+		t.Error("expected invalid boost error")
+	}
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/markopolo123/pico_templ/head"
+)
+
+// runAssets runs an assets subcommand.
+func runAssets(args []string, stdout io.Writer) error {
+	if len(args) == 0 || args[0] != "update" {
+		return errors.New("usage: pico_templ assets update [flags]")
+	}
+	return runAssetsUpdate(args[1:], stdout)
+}
+
+// runAssetsUpdate downloads the vendored assets at the requested versions,
+// reports how their versions and hashes changed and rewrites the assets and
+// manifest.go of the head package. Pico CSS variants are written to the css
+// directory of the picovariants package instead, so only binaries importing
+// it embed them.
+// Files at an unchanged version must match their recorded hash, and files
+// without one are only written with -accept-new, so a first download is
+// reviewed rather than trusted.
+func runAssetsUpdate(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("assets update", flag.ContinueOnError)
+	dir := fs.String("dir", "head", "head package directory to update")
+	baseURL := fs.String("base-url", head.DefaultBaseURL, "npm CDN or mirror to download from")
+	pico := fs.String("pico", head.PicoCSSVersion, "Pico CSS version or range, e.g. 2.x")
+	htmx := fs.String("htmx", head.HTMXVersion, "HTMX version or range, e.g. 2.x")
+	hyperscript := fs.String("hyperscript", head.HyperscriptVersion, "_hyperscript version or range")
+	dryRun := fs.Bool("dry-run", false, "report changes without writing files")
+	acceptNew := fs.Bool("accept-new", false, "record the hashes of files that have no recorded hash, after reviewing them")
+	requested := map[string]string{}
+	fs.Func("ext", "HTMX extension version or range as name@version, e.g. sse@2.x (repeatable)", func(value string) error {
+		name, version, ok := strings.Cut(value, "@")
+		if _, known := head.HTMXExtensionVersions[name]; !ok || !known || version == "" {
+			return fmt.Errorf("expected name@version of one of %s", strings.Join(head.Extensions(), ", "))
+		}
+		requested["htmx-ext-"+name] = version
+		return nil
+	})
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	requested["@picocss/pico"] = *pico
+	requested["htmx.org"] = *htmx
+	requested["hyperscript.org"] = *hyperscript
+	base := strings.TrimSuffix(*baseURL, "/")
+
+	client := &http.Client{Timeout: time.Minute}
+	sources := head.Sources()
+	files := map[string][]byte{}
+	hashes := map[string]string{}
+	var unrecorded []string
+	unchanged := 0
+	for i, source := range sources {
+		spec := requested[source.Package]
+		if spec == "" {
+			spec = source.Version
+		}
+		version, err := resolveVersion(client, base, source.Package, spec)
+		if err != nil {
+			return err
+		}
+		if version != source.Version {
+			fmt.Fprintf(stdout, "%s %s -> %s\n", source.Package, source.Version, version)
+		}
+		updated := source
+		updated.Version = version
+		for _, file := range source.FileNames() {
+			data, err := fetch(client, updated.URL(base, file))
+			if err != nil {
+				return fmt.Errorf("%s: %w", file, err)
+			}
+			sum := sha256.Sum256(data)
+			hash := hex.EncodeToString(sum[:])
+			recorded, ok := head.AssetHashes[file]
+			switch {
+			case !ok:
+				fmt.Fprintf(stdout, "+ %s %s\n", file, hash)
+				unrecorded = append(unrecorded, file)
+			case recorded == hash:
+				unchanged++
+			case version == source.Version:
+				return fmt.Errorf("%s: hash mismatch at unchanged version %s: expected %s, got %s", file, version, recorded, hash)
+			default:
+				fmt.Fprintf(stdout, "~ %s %s -> %s\n", file, recorded[:12], hash[:12])
+			}
+			files[file] = data
+			hashes[file] = hash
+		}
+		sources[i] = updated
+	}
+	fmt.Fprintf(stdout, "%d of %d files unchanged\n", unchanged, len(files))
+	if *dryRun {
+		return nil
+	}
+	if len(unrecorded) > 0 && !*acceptNew {
+		return fmt.Errorf("%d files have no recorded hash (%s); check the hashes above against the upstream release, then rerun with -accept-new",
+			len(unrecorded), strings.Join(unrecorded, ", "))
+	}
+
+	assetsDir := filepath.Join(*dir, "assets")
+	variantsDir := filepath.Join(*dir, "picovariants", "css")
+	for _, d := range []string{assetsDir, variantsDir} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			return err
+		}
+	}
+	variants := map[string]bool{}
+	for _, file := range head.PicoVariants() {
+		variants[file] = true
+	}
+	for file, data := range files {
+		target := filepath.Join(assetsDir, file)
+		if variants[file] {
+			target = filepath.Join(variantsDir, file)
+		}
+		if err := os.WriteFile(target, data, 0644); err != nil {
+			return err
+		}
+	}
+	manifest, err := renderManifest(sources, hashes)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(*dir, "manifest.go"), manifest, 0644)
+}
+
+// exactVersion matches a version that needs no resolving.
+var exactVersion = regexp.MustCompile(`^\d+\.\d+\.\d+(-[0-9A-Za-z.-]+)?$`)
+
+// resolveVersion resolves a version range such as 2.x to the exact version
+// the CDN serves, using the package.json it serves for the range.
+func resolveVersion(client *http.Client, baseURL, pkg, spec string) (string, error) {
+	if exactVersion.MatchString(spec) {
+		return spec, nil
+	}
+	data, err := fetch(client, baseURL+"/"+pkg+"@"+spec+"/package.json")
+	if err != nil {
+		return "", fmt.Errorf("resolving %s@%s: %w", pkg, spec, err)
+	}
+	var manifest struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return "", fmt.Errorf("resolving %s@%s: %w", pkg, spec, err)
+	}
+	if !exactVersion.MatchString(manifest.Version) {
+		return "", fmt.Errorf("resolving %s@%s: unexpected version %q", pkg, spec, manifest.Version)
+	}
+	return manifest.Version, nil
+}
+
+// fetch returns the body of a successful GET request to url.
+func fetch(client *http.Client, url string) ([]byte, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// versionConsts names the manifest.go constant holding each package version.
+var versionConsts = []struct{ name, pkg string }{
+	{"PicoCSSVersion", "@picocss/pico"},
+	{"HTMXVersion", "htmx.org"},
+	{"HyperscriptVersion", "hyperscript.org"},
+}
+
+// renderManifest returns the source of head/manifest.go recording the
+// versions of sources and the hashes of the vendored files.
+func renderManifest(sources []head.Source, hashes map[string]string) ([]byte, error) {
+	versions := map[string]string{}
+	for _, source := range sources {
+		versions[source.Package] = source.Version
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by pico_templ assets update; DO NOT EDIT.\n\npackage head\n\n")
+	buf.WriteString("// Vendored asset versions.\nconst (\n")
+	for _, c := range versionConsts {
+		fmt.Fprintf(&buf, "\t%s = %q\n", c.name, versions[c.pkg])
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("// HTMXExtensionVersions pins the version of each vendored HTMX extension.\n")
+	buf.WriteString("var HTMXExtensionVersions = map[string]string{\n")
+	for _, name := range head.Extensions() {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", name, versions["htmx-ext-"+name])
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// AssetHashes contains the SHA256 hash of each vendored asset.\n")
+	buf.WriteString("var AssetHashes = map[string]string{\n")
+	files := make([]string, 0, len(hashes))
+	for file := range hashes {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", file, hashes[file])
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
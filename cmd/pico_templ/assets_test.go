@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/markopolo123/pico_templ/head"
+)
+
+// mirror serves every source file: the embedded copy at the vendored version,
+// or placeholder content at any other version. Ranges resolve to 2.9.9.
+func mirror(t *testing.T, tampered string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, source := range head.Sources() {
+			prefix := "/" + source.Package + "@"
+			if !strings.HasPrefix(r.URL.Path, prefix) {
+				continue
+			}
+			version, path, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, prefix), "/")
+			if path == "package.json" {
+				w.Write([]byte(`{"name":"` + source.Package + `","version":"2.9.9"}`))
+				return
+			}
+			for file, filePath := range source.Files {
+				if filePath != path {
+					continue
+				}
+				data, err := head.Assets.ReadFile("assets/" + file)
+				if version != source.Version || err != nil || file == tampered {
+					data = []byte("/* " + r.URL.Path + " */")
+				}
+				w.Write(data)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAssetsUpdateDryRun(t *testing.T) {
+	server := mirror(t, "")
+	dir := t.TempDir()
+
+	var out bytes.Buffer
+	err := runAssets([]string{"update", "-dir", dir, "-base-url", server.URL, "-dry-run"}, &out)
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+
+	if !strings.Contains(out.String(), "3 of ") || strings.Contains(out.String(), "->") {
+		t.Errorf("expected core assets unchanged, got: %s", out.String())
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("expected no files to be written, got %d", len(entries))
+	}
+}
+
+func TestAssetsUpdateResolvesRanges(t *testing.T) {
+	server := mirror(t, "")
+	dir := t.TempDir()
+
+	var out bytes.Buffer
+	err := runAssets([]string{"update", "-dir", dir, "-base-url", server.URL + "/", "--htmx", "2.x", "-ext", "sse@2.x", "-accept-new"}, &out)
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+
+	expectations := []string{
+		"htmx.org " + head.HTMXVersion + " -> 2.9.9\n",
+		"~ htmx.min.js " + head.AssetHashes["htmx.min.js"][:12] + " -> ",
+		"htmx-ext-sse " + head.HTMXExtensionVersions["sse"] + " -> 2.9.9\n",
+		"+ htmx-ext-sse.js ",
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(out.String(), exp) {
+			t.Errorf("expected %q, got: %s", exp, out.String())
+		}
+	}
+
+	manifest, err := os.ReadFile(filepath.Join(dir, "manifest.go"))
+	if err != nil {
+		t.Fatalf("expected manifest.go: %v", err)
+	}
+	for _, exp := range []string{`HTMXVersion        = "2.9.9"`, `PicoCSSVersion     = "` + head.PicoCSSVersion + `"`, `"sse":              "2.9.9"`, `"htmx-ext-sse.js":`} {
+		if !strings.Contains(string(manifest), exp) {
+			t.Errorf("expected %s in manifest, got: %s", exp, manifest)
+		}
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "assets", "htmx.min.js"))
+	if err != nil || string(data) != "/* /htmx.org@2.9.9/dist/htmx.min.js */" {
+		t.Errorf("expected downloaded htmx, got %q (%v)", data, err)
+	}
+
+	variant := "pico.classless.jade.min.css"
+	if _, err := os.Stat(filepath.Join(dir, "picovariants", "css", variant)); err != nil {
+		t.Errorf("expected %s in the picovariants package: %v", variant, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "assets", variant)); err == nil {
+		t.Errorf("expected %s not to be embedded by head", variant)
+	}
+}
+
+func TestAssetsUpdateRejectsChangedHashAtSameVersion(t *testing.T) {
+	server := mirror(t, "htmx.min.js")
+	dir := t.TempDir()
+
+	var out bytes.Buffer
+	err := runAssets([]string{"update", "-dir", dir, "-base-url", server.URL}, &out)
+	if err == nil || !strings.Contains(err.Error(), "hash mismatch at unchanged version") {
+		t.Errorf("expected hash mismatch error, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "manifest.go")); err == nil {
+		t.Error("expected manifest not to be written")
+	}
+}
+
+func TestAssetsUpdateRequiresAcceptNewForUnrecordedFiles(t *testing.T) {
+	server := mirror(t, "")
+	dir := t.TempDir()
+
+	var out bytes.Buffer
+	err := runAssets([]string{"update", "-dir", dir, "-base-url", server.URL}, &out)
+	if err == nil || !strings.Contains(err.Error(), "-accept-new") || !strings.Contains(err.Error(), "htmx-ext-sse.js") {
+		t.Errorf("expected unrecorded hash error, got %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("expected no files to be written, got %d", len(entries))
+	}
+	sum := sha256.Sum256([]byte("/* /htmx-ext-sse@" + head.HTMXExtensionVersions["sse"] + "/sse.js */"))
+	if !strings.Contains(out.String(), "+ htmx-ext-sse.js "+hex.EncodeToString(sum[:])+"\n") {
+		t.Errorf("expected the full hash of the new file for review, got: %s", out.String())
+	}
+}
+
+func TestAssetsUpdateRejectsUnknownExtension(t *testing.T) {
+	err := runAssets([]string{"update", "-ext", "nope@1.0.0"}, &bytes.Buffer{})
+	if err == nil {
+		t.Error("expected unknown extension error")
+	}
+}
+
+func TestRenderManifestMatchesHeadPackage(t *testing.T) {
+	want, err := os.ReadFile("../../head/manifest.go")
+	if err != nil {
+		t.Fatalf("failed to read manifest: %v", err)
+	}
+	got, err := renderManifest(head.Sources(), head.AssetHashes)
+	if err != nil {
+		t.Fatalf("failed to render manifest: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("expected generated manifest to match head/manifest.go, got:\n%s", got)
+	}
+}
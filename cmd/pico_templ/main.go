@@ -13,14 +13,33 @@ var (
 )
 
 func main() {
-	if len(os.Args) > 1 && os.Args[1] == "version" {
-		fmt.Printf("pico_templ %s (commit: %s, built: %s)\n", version, commit, date)
-		return
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "version":
+			fmt.Printf("pico_templ %s (commit: %s, built: %s)\n", version, commit, date)
+			return
+		case "theme":
+			if err := runTheme(os.Args[2:], os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "pico_templ theme: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		case "assets":
+			if err := runAssets(os.Args[2:], os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "pico_templ assets: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
 	}
 
 	fmt.Println("pico_templ - Pico CSS component library for Go templ")
 	fmt.Printf("Version: %s\n", version)
 	fmt.Println("\nThis is a library package. Import it in your Go project:")
 	fmt.Println("  import \"github.com/markopolo123/pico_templ/components/button\"")
+	fmt.Println("\nCommands:")
+	fmt.Println("  version  Print version information")
+	fmt.Println("  theme    Generate a Pico CSS theme stylesheet (see pico_templ theme -h)")
+	fmt.Println("  assets   Update the vendored assets (see pico_templ assets update -h)")
 	fmt.Println("\nFor documentation, visit: https://github.com/markopolo123/pico_templ")
 }
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/markopolo123/pico_templ/theme"
+)
+
+// runTheme writes the stylesheet of a theme built from a JSON config and flags.
+func runTheme(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("theme", flag.ContinueOnError)
+	config := fs.String("config", "", "JSON theme config file")
+	output := fs.String("o", "", "output .css file (default stdout)")
+	hue := fs.Int("hue", 0, "primary hue (1-360)")
+	font := fs.String("font", "", "font family")
+	spacing := fs.String("spacing", "", "base spacing, e.g. 1rem")
+	radius := fs.String("radius", "", "border radius, e.g. 0.5rem")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var t theme.Theme
+	if *config != "" {
+		data, err := os.ReadFile(*config)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &t); err != nil {
+			return fmt.Errorf("parsing %s: %w", *config, err)
+		}
+	}
+	// Flags override values from the config file.
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "hue":
+			t.PrimaryHue = *hue
+		case "font":
+			t.FontFamily = *font
+		case "spacing":
+			t.Spacing = *spacing
+		case "radius":
+			t.BorderRadius = *radius
+		}
+	})
+
+	css, err := t.CSS()
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err = io.WriteString(stdout, css)
+		return err
+	}
+	return os.WriteFile(*output, []byte(css), 0644)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/markopolo123/pico_templ/theme"
+)
+
+// writeConfig writes a theme config file and returns its path.
+func writeConfig(t *testing.T, config string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "theme.json")
+	if err := os.WriteFile(path, []byte(config), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	return path
+}
+
+func TestThemeFromFlags(t *testing.T) {
+	var out bytes.Buffer
+	if err := runTheme([]string{"-hue", "200", "-font", "Inter", "-spacing", "1rem", "-radius", "0.5rem"}, &out); err != nil {
+		t.Fatalf("theme failed: %v", err)
+	}
+
+	want, err := theme.Theme{PrimaryHue: 200, FontFamily: "Inter", Spacing: "1rem", BorderRadius: "0.5rem"}.CSS()
+	if err != nil {
+		t.Fatalf("failed to build theme: %v", err)
+	}
+	if out.String() != want {
+		t.Errorf("expected %s, got %s", want, out.String())
+	}
+}
+
+func TestThemeFlagsOverrideConfig(t *testing.T) {
+	config := writeConfig(t, `{"primaryHue": 120, "fontFamily": "Inter", "spacing": "2rem"}`)
+
+	var out bytes.Buffer
+	if err := runTheme([]string{"-config", config, "-hue", "200", "-font="}, &out); err != nil {
+		t.Fatalf("theme failed: %v", err)
+	}
+
+	css := out.String()
+	if !strings.Contains(css, "hsl(200, ") || strings.Contains(css, "hsl(120, ") {
+		t.Errorf("expected -hue to override the config hue, got: %s", css)
+	}
+	if strings.Contains(css, "--pico-font-family") {
+		t.Errorf("expected an explicitly empty -font to clear the config font, got: %s", css)
+	}
+	if !strings.Contains(css, "--pico-spacing: 2rem;") {
+		t.Errorf("expected spacing from the config, got: %s", css)
+	}
+}
+
+func TestThemeWritesOutputFile(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "theme.css")
+
+	var out bytes.Buffer
+	if err := runTheme([]string{"-config", writeConfig(t, `{"borderRadius": "1rem"}`), "-o", output}, &out); err != nil {
+		t.Fatalf("theme failed: %v", err)
+	}
+
+	if out.Len() != 0 {
+		t.Errorf("expected nothing on stdout, got: %s", out.String())
+	}
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatalf("expected output file: %v", err)
+	}
+	if !strings.Contains(string(data), "--pico-border-radius: 1rem;") {
+		t.Errorf("expected border radius in output file, got: %s", data)
+	}
+}
+
+func TestThemeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing config", []string{"-config", filepath.Join(t.TempDir(), "missing.json")}, "missing.json"},
+		{"invalid config", []string{"-config", writeConfig(t, `{"primaryHue": "red"}`)}, "parsing "},
+		{"invalid hue", []string{"-hue", "999"}, "out of range"},
+		{"unknown flag", []string{"-color", "red"}, "-color"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runTheme(tt.args, &bytes.Buffer{})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
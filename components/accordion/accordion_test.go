@@ -0,0 +1,147 @@
+package accordion
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+func TestAccordion(t *testing.T) {
+	tests := []struct {
+		name     string
+		props    Props
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "default renders div without hyperscript",
+			props:    Props{},
+			contains: []string{`<div>`},
+			excludes: []string{`_=`, `id=`, `class=`},
+		},
+		{
+			name:     "ID and class",
+			props:    Props{ID: "faq", Class: "faq-list"},
+			contains: []string{`id="faq"`, `class="faq-list"`},
+		},
+		{
+			name:     "exclusive adds hyperscript",
+			props:    Props{Exclusive: true},
+			contains: []string{`_="on toggle from &lt;details/&gt; in me`, `set item.open to false`},
+		},
+		{
+			name:     "attrs spread",
+			props:    Props{Attrs: templ.Attributes{"data-testid": "accordion"}},
+			contains: []string{`data-testid="accordion"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := render(t, Accordion(tt.props))
+			for _, want := range tt.contains {
+				if !strings.Contains(html, want) {
+					t.Errorf("expected %s, got: %s", want, html)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(html, unwanted) {
+					t.Errorf("did not expect %s, got: %s", unwanted, html)
+				}
+			}
+		})
+	}
+}
+
+func TestAccordionItem(t *testing.T) {
+	tests := []struct {
+		name     string
+		props    ItemProps
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "renders details and summary",
+			props:    ItemProps{Title: "Section 1"},
+			contains: []string{`<details>`, `<summary>Section 1</summary>`, `</details>`},
+			excludes: []string{` open`, `hx-get`},
+		},
+		{
+			name:     "open attribute",
+			props:    ItemProps{Title: "Open", Open: true},
+			contains: []string{`<details open>`},
+		},
+		{
+			name:     "native exclusive name",
+			props:    ItemProps{Title: "Named", Name: "faq"},
+			contains: []string{`name="faq"`},
+		},
+		{
+			name:     "ID and class",
+			props:    ItemProps{ID: "item-1", Class: "outline"},
+			contains: []string{`id="item-1"`, `class="outline"`},
+		},
+		{
+			name:  "lazy load on first toggle",
+			props: ItemProps{Title: "Lazy", LazyURL: "/faq/1"},
+			contains: []string{
+				`hx-get="/faq/1"`,
+				`hx-trigger="toggle once from:closest details"`,
+				`hx-swap="outerHTML"`,
+				`aria-busy="true"`,
+			},
+		},
+		{
+			name:     "lazy load immediately when open",
+			props:    ItemProps{Title: "Lazy", LazyURL: "/faq/2", Open: true},
+			contains: []string{`hx-get="/faq/2"`, `hx-trigger="load"`},
+		},
+		{
+			name:  "htmx attrs on the lazy body",
+			props: ItemProps{Title: "Lazy", LazyURL: "/faq/3", Htmx: attrs.HtmxAttrs{Trigger: "revealed", Indicator: "#spinner"}},
+			contains: []string{
+				`<div aria-busy="true" hx-get="/faq/3" hx-swap="outerHTML" hx-trigger="revealed" hx-indicator="#spinner">`,
+			},
+		},
+		{
+			name:     "htmx get enables lazy loading",
+			props:    ItemProps{Title: "Lazy", Htmx: attrs.HtmxAttrs{Get: "/faq/4"}},
+			contains: []string{`aria-busy="true"`, `hx-get="/faq/4"`, `hx-trigger="toggle once from:closest details"`},
+		},
+		{
+			name:     "attrs spread",
+			props:    ItemProps{Attrs: templ.Attributes{"data-testid": "item"}},
+			contains: []string{`data-testid="item"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := render(t, AccordionItem(tt.props))
+			for _, want := range tt.contains {
+				if !strings.Contains(html, want) {
+					t.Errorf("expected %s, got: %s", want, html)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(html, unwanted) {
+					t.Errorf("did not expect %s, got: %s", unwanted, html)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package breadcrumb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+var trail = []Crumb{
+	{Label: "Home", Href: "/"},
+	{Label: "Books", Href: "/books"},
+	{Label: "Dune", Href: "/books/dune"},
+}
+
+func TestBreadcrumb_RendersNavWithAriaLabel(t *testing.T) {
+	html := render(t, Breadcrumb(Props{Crumbs: trail}))
+
+	if !strings.HasPrefix(html, `<nav aria-label="breadcrumb">`) {
+		t.Errorf("expected breadcrumb nav, got: %s", html)
+	}
+}
+
+func TestBreadcrumb_LinksAllButLast(t *testing.T) {
+	html := render(t, Breadcrumb(Props{Crumbs: trail}))
+
+	if !strings.Contains(html, `<li><a href="/">Home</a></li>`) {
+		t.Errorf("expected home link, got: %s", html)
+	}
+	if !strings.Contains(html, `<li><a href="/books">Books</a></li>`) {
+		t.Errorf("expected books link, got: %s", html)
+	}
+	if strings.Contains(html, `href="/books/dune"`) {
+		t.Errorf("expected last crumb not to be a link, got: %s", html)
+	}
+}
+
+func TestBreadcrumb_LastIsCurrentPage(t *testing.T) {
+	html := render(t, Breadcrumb(Props{Crumbs: trail}))
+
+	if !strings.Contains(html, `<li aria-current="page">Dune</li>`) {
+		t.Errorf("expected aria-current on last crumb, got: %s", html)
+	}
+	if strings.Count(html, `aria-current`) != 1 {
+		t.Errorf("expected exactly one aria-current, got: %s", html)
+	}
+}
+
+func TestBreadcrumb_NoStructuredDataByDefault(t *testing.T) {
+	html := render(t, Breadcrumb(Props{Crumbs: trail}))
+
+	if strings.Contains(html, "application/ld+json") {
+		t.Errorf("expected no JSON-LD by default, got: %s", html)
+	}
+}
+
+func TestBreadcrumb_StructuredData(t *testing.T) {
+	html := render(t, Breadcrumb(Props{
+		Crumbs:         trail,
+		StructuredData: true,
+		BaseURL:        "https://example.com/shop/",
+	}))
+
+	start := strings.Index(html, `<script type="application/ld+json">`)
+	if start == -1 {
+		t.Fatalf("expected JSON-LD script, got: %s", html)
+	}
+	body := html[start+len(`<script type="application/ld+json">`):]
+	body = body[:strings.Index(body, "</script>")]
+
+	var doc breadcrumbList
+	if err := json.Unmarshal([]byte(body), &doc); err != nil {
+		t.Fatalf("invalid JSON-LD: %v\n%s", err, body)
+	}
+	if doc.Context != "https://schema.org" || doc.Type != "BreadcrumbList" {
+		t.Errorf("unexpected context/type: %+v", doc)
+	}
+	if len(doc.ItemListElement) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(doc.ItemListElement))
+	}
+
+	tests := []struct {
+		position int
+		name     string
+		item     string
+	}{
+		{1, "Home", "https://example.com/"},
+		{2, "Books", "https://example.com/books"},
+		{3, "Dune", ""},
+	}
+	for i, tt := range tests {
+		got := doc.ItemListElement[i]
+		if got.Type != "ListItem" || got.Position != tt.position || got.Name != tt.name || got.Item != tt.item {
+			t.Errorf("item %d: expected %+v, got %+v", i, tt, got)
+		}
+	}
+}
+
+func TestBreadcrumb_StructuredDataRelativeWithoutBaseURL(t *testing.T) {
+	props := Props{Crumbs: trail}
+	doc := props.structuredData()
+
+	if doc.ItemListElement[1].Item != "/books" {
+		t.Errorf("expected unresolved href, got %q", doc.ItemListElement[1].Item)
+	}
+}
+
+func TestBreadcrumb_HtmxOnEveryCrumb(t *testing.T) {
+	html := render(t, Breadcrumb(Props{
+		Crumbs: trail,
+		Htmx:   attrs.HtmxAttrs{Target: "#main", PushURL: "true"},
+	}))
+
+	if strings.Count(html, `hx-target="#main"`) != 2 {
+		t.Errorf("expected hx-target on both linked crumbs, got: %s", html)
+	}
+	if !strings.Contains(html, `hx-get="/books"`) {
+		t.Errorf("expected hx-get to default to crumb href, got: %s", html)
+	}
+	if !strings.Contains(html, `hx-push-url="true"`) {
+		t.Errorf("expected hx-push-url, got: %s", html)
+	}
+}
+
+func TestBreadcrumb_ExplicitHtmxGet(t *testing.T) {
+	html := render(t, Breadcrumb(Props{
+		Crumbs: trail,
+		Htmx:   attrs.HtmxAttrs{Get: "/partial", Target: "#main"},
+	}))
+
+	if strings.Count(html, `hx-get="/partial"`) != 2 {
+		t.Errorf("expected explicit hx-get on every crumb, got: %s", html)
+	}
+}
+
+func TestBreadcrumb_ClassAndAttrs(t *testing.T) {
+	html := render(t, Breadcrumb(Props{
+		Crumbs: trail,
+		Class:  "trail",
+		Attrs:  templ.Attributes{"data-testid": "breadcrumb"},
+	}))
+
+	if !strings.Contains(html, `class="trail"`) {
+		t.Errorf("expected class attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `data-testid="breadcrumb"`) {
+		t.Errorf("expected data-testid attribute, got: %s", html)
+	}
+}
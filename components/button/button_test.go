@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
+	"github.com/markopolo123/pico_templ/attrs"
 )
 
 func render(t *testing.T, component templ.Component) string {
@@ -93,6 +95,13 @@ func TestHtmxAttributes(t *testing.T) {
 	}
 }
 
+func TestHtmxPassesExtensionSwapThrough(t *testing.T) {
+	html := render(t, Button(Props{Text: "Morph", HxGet: "/a", HxSwap: "morph"}))
+	if !strings.Contains(html, `hx-swap="morph"`) {
+		t.Errorf("expected hx-swap=morph, got: %s", html)
+	}
+}
+
 func TestTypeSubmit(t *testing.T) {
 	html := render(t, Button(Props{Text: "Submit", Type: "submit"}))
 	if !strings.Contains(html, `type="submit"`) {
@@ -129,3 +138,69 @@ func TestAttrsSpread(t *testing.T) {
 		t.Errorf("expected aria-label attribute, got: %s", html)
 	}
 }
+
+func TestTooltip(t *testing.T) {
+	html := render(t, Button(Props{
+		Text:             "Save",
+		Tooltip:          "Saves the draft",
+		TooltipPlacement: attrs.PlacementBottom,
+	}))
+	if !strings.Contains(html, `data-tooltip="Saves the draft"`) {
+		t.Errorf("expected data-tooltip attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `data-placement="bottom"`) {
+		t.Errorf("expected data-placement attribute, got: %s", html)
+	}
+}
+
+func TestTooltipInvalidPlacement(t *testing.T) {
+	var buf bytes.Buffer
+	err := Button(Props{Text: "Save", Tooltip: "Tip", TooltipPlacement: "diagonal"}).Render(context.Background(), &buf)
+	if err == nil {
+		t.Errorf("expected render error for invalid placement, got: %s", buf.String())
+	}
+}
+
+func TestButtonRequiresHTMXOnlyWithRequest(t *testing.T) {
+	tests := []struct {
+		props Props
+		want  int
+	}{
+		{Props{Text: "Plain"}, 0},
+		{Props{Text: "Load", HxGet: "/load"}, 1},
+	}
+	for _, tt := range tests {
+		ctx := assets.WithRegistry(context.Background())
+		var buf bytes.Buffer
+		if err := Button(tt.props).Render(ctx, &buf); err != nil {
+			t.Fatalf("failed to render component: %v", err)
+		}
+		if got := len(assets.FromContext(ctx).Pending().Assets); got != tt.want {
+			t.Errorf("%s: expected %d required assets, got %d", tt.props.Text, tt.want, got)
+		}
+	}
+}
+
+func TestHtmxField(t *testing.T) {
+	html := render(t, Button(Props{
+		Text: "Delete",
+		Htmx: attrs.HtmxAttrs{Delete: "/item/1", Confirm: "Sure?", Target: "closest tr"},
+	}))
+
+	if !strings.Contains(html, `hx-delete="/item/1" hx-target="closest tr" hx-confirm="Sure?"`) {
+		t.Errorf("expected HTMX attributes, got: %s", html)
+	}
+}
+
+func TestHtmxFieldOverridesDeprecatedFields(t *testing.T) {
+	html := render(t, Button(Props{
+		Text:     "Load",
+		Htmx:     attrs.HtmxAttrs{Get: "/new"},
+		HxGet:    "/old",
+		HxTarget: "#result",
+	}))
+
+	if !strings.Contains(html, `hx-get="/new" hx-target="#result"`) || strings.Contains(html, "/old") {
+		t.Errorf("expected Htmx.Get to win and HxTarget to apply, got: %s", html)
+	}
+}
@@ -1,7 +1,10 @@
 // Package button provides a Button component for Pico CSS.
 package button
 
-import "github.com/a-h/templ"
+import (
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
+)
 
 // Variant constants for button styling.
 const (
@@ -18,15 +21,19 @@ type Props struct {
 	Outline  bool   // Add .outline class
 	Disabled bool   // Disabled state
 	Class    string // Additional CSS classes
-	// HTMX bindings
-	HxGet     string
-	HxPost    string
-	HxPut     string
-	HxDelete  string
-	HxPatch   string
-	HxTarget  string
-	HxSwap    string
-	HxTrigger string
+	// Tooltip
+	Tooltip          string          // data-tooltip text
+	TooltipPlacement attrs.Placement // top, right, bottom or left (default top)
+	Htmx             attrs.HtmxAttrs // HTMX attributes
+	// Deprecated HTMX bindings, used when the matching Htmx field is empty.
+	HxGet     string           // Deprecated: use Htmx.Get.
+	HxPost    string           // Deprecated: use Htmx.Post.
+	HxPut     string           // Deprecated: use Htmx.Put.
+	HxDelete  string           // Deprecated: use Htmx.Delete.
+	HxPatch   string           // Deprecated: use Htmx.Patch.
+	HxTarget  string           // Deprecated: use Htmx.Target.
+	HxSwap    string           // Deprecated: use Htmx.Swap.
+	HxTrigger string           // Deprecated: use Htmx.Trigger.
 	Attrs     templ.Attributes // Arbitrary additional attributes
 }
 
@@ -38,6 +45,20 @@ func (p Props) buttonType() string {
 	return p.Type
 }
 
+// htmx returns the HTMX attributes, falling back to the deprecated Hx* fields.
+func (p Props) htmx() attrs.HtmxAttrs {
+	return p.Htmx.WithDefaults(attrs.HtmxAttrs{
+		Get:     p.HxGet,
+		Post:    p.HxPost,
+		Put:     p.HxPut,
+		Delete:  p.HxDelete,
+		Patch:   p.HxPatch,
+		Target:  p.HxTarget,
+		Swap:    p.HxSwap,
+		Trigger: p.HxTrigger,
+	})
+}
+
 // classes builds the CSS class string for the button.
 func (p Props) classes() string {
 	var result string
@@ -0,0 +1,256 @@
+package dropdown
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+func TestDropdown_RendersDetailsDropdown(t *testing.T) {
+	html := render(t, Dropdown(Props{Label: "Menu"}))
+
+	if !strings.Contains(html, `<details class="dropdown"`) {
+		t.Errorf("expected details.dropdown, got: %s", html)
+	}
+	if !strings.Contains(html, `<summary>Menu</summary>`) {
+		t.Errorf("expected summary label, got: %s", html)
+	}
+	if !strings.Contains(html, `<ul>`) {
+		t.Errorf("expected menu list, got: %s", html)
+	}
+}
+
+func TestDropdown_KeyboardAndOutsideClickHyperscript(t *testing.T) {
+	html := render(t, Dropdown(Props{Label: "Menu"}))
+
+	expectations := []string{
+		`on click from elsewhere remove @open`,
+		`on keydown[key is &#39;Escape&#39;] remove @open`,
+		`ArrowDown`,
+		`ArrowUp`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+}
+
+func TestDropdown_ButtonSummary(t *testing.T) {
+	html := render(t, Dropdown(Props{Label: "Actions", Button: true, Variant: "secondary"}))
+
+	if !strings.Contains(html, `<summary role="button" class="secondary">`) {
+		t.Errorf("expected button summary with variant, got: %s", html)
+	}
+}
+
+func TestDropdown_AlignRight(t *testing.T) {
+	html := render(t, Dropdown(Props{Label: "Menu", AlignRight: true}))
+
+	if !strings.Contains(html, `<ul dir="rtl">`) {
+		t.Errorf("expected dir=rtl on menu, got: %s", html)
+	}
+}
+
+func TestDropdown_IDAndClass(t *testing.T) {
+	html := render(t, Dropdown(Props{ID: "menu", Label: "Menu", Class: "compact"}))
+
+	if !strings.Contains(html, `class="dropdown compact"`) {
+		t.Errorf("expected dropdown and custom class, got: %s", html)
+	}
+	if !strings.Contains(html, `id="menu"`) {
+		t.Errorf("expected id attribute, got: %s", html)
+	}
+}
+
+func TestDropdown_SelectMode(t *testing.T) {
+	html := render(t, Dropdown(Props{
+		Label: "Choose",
+		Name:  "size",
+		Value: "m",
+		Items: []Item{
+			{Label: "Small", Value: "s"},
+			{Label: "Medium", Value: "m"},
+		},
+	}))
+
+	if !strings.Contains(html, `<input type="hidden" name="size" value="m">`) {
+		t.Errorf("expected hidden input, got: %s", html)
+	}
+	if !strings.Contains(html, `<summary>Medium</summary>`) {
+		t.Errorf("expected selected label in summary, got: %s", html)
+	}
+	if !strings.Contains(html, `data-value="s"`) {
+		t.Errorf("expected data-value on item, got: %s", html)
+	}
+	if !strings.Contains(html, `set field.value to @data-value`) {
+		t.Errorf("expected select hyperscript on item, got: %s", html)
+	}
+}
+
+func TestDropdown_SelectModeChildren(t *testing.T) {
+	child := DropdownItem(Item{Label: "Large", Value: "l"})
+	var buf bytes.Buffer
+	err := Dropdown(Props{Label: "Choose", Name: "size"}).Render(templ.WithChildren(context.Background(), child), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+
+	html := buf.String()
+	if !strings.Contains(html, `data-value="l"`) || !strings.Contains(html, `set field.value to @data-value`) {
+		t.Errorf("expected select hyperscript on child item, got: %s", html)
+	}
+}
+
+func TestDropdown_ValueIgnoredOutsideSelectMode(t *testing.T) {
+	html := render(t, Dropdown(Props{
+		Label: "Menu",
+		Items: []Item{{Label: "Profile", Href: "/profile", Value: "profile"}},
+	}))
+
+	if !strings.Contains(html, `href="/profile"`) {
+		t.Errorf("expected item link, got: %s", html)
+	}
+	if strings.Contains(html, `data-value`) || strings.Contains(html, `_="on click halt`) {
+		t.Errorf("expected no select hyperscript outside select mode, got: %s", html)
+	}
+}
+
+func TestDropdown_NoHiddenInputWithoutName(t *testing.T) {
+	html := render(t, Dropdown(Props{Label: "Menu"}))
+
+	if strings.Contains(html, `type="hidden"`) {
+		t.Errorf("expected no hidden input outside select mode, got: %s", html)
+	}
+}
+
+func TestDropdownItem(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     Item
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "link",
+			item:     Item{Label: "Profile", Href: "/profile"},
+			contains: []string{`<li><a href="/profile">Profile</a></li>`},
+		},
+		{
+			name:     "defaults href for focus",
+			item:     Item{Label: "Action"},
+			contains: []string{`href="#"`},
+		},
+		{
+			name:     "htmx verb and target",
+			item:     Item{Label: "Archive", Method: Post, URL: "/archive", Target: "#list"},
+			contains: []string{`hx-post="/archive"`, `hx-target="#list"`},
+		},
+		{
+			name:     "htmx verb without url is ignored",
+			item:     Item{Label: "Archive", Method: Delete},
+			excludes: []string{`hx-delete`},
+		},
+		{
+			name:     "htmx attrs",
+			item:     Item{Label: "Archive", Htmx: attrs.HtmxAttrs{Patch: "/archive", Swap: "outerHTML"}, Target: "#list"},
+			contains: []string{`hx-patch="/archive"`, `hx-swap="outerHTML"`, `hx-target="#list"`},
+		},
+		{
+			name:     "htmx attrs override method and url",
+			item:     Item{Label: "Archive", Method: Post, URL: "/old", Htmx: attrs.HtmxAttrs{Put: "/new", Target: "#row"}, Target: "#list"},
+			contains: []string{`hx-put="/new"`, `hx-target="#row"`},
+			excludes: []string{`hx-post`, `#list`},
+		},
+		{
+			name:     "disabled drops htmx attrs",
+			item:     Item{Label: "Nope", Htmx: attrs.HtmxAttrs{Get: "/nope"}, Disabled: true},
+			excludes: []string{`hx-get`},
+		},
+		{
+			name:     "disabled",
+			item:     Item{Label: "Nope", Href: "/nope", Method: Get, URL: "/nope", Value: "x", Disabled: true},
+			contains: []string{`aria-disabled="true"`},
+			excludes: []string{`href=`, `hx-get`, `_=`},
+		},
+		{
+			name:     "danger",
+			item:     Item{Label: "Delete", Danger: true},
+			contains: []string{`class="danger"`, `--pico-del-color`},
+		},
+		{
+			name:     "attrs spread",
+			item:     Item{Label: "Custom", Attrs: templ.Attributes{"data-testid": "item"}},
+			contains: []string{`data-testid="item"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := render(t, DropdownItem(tt.item))
+			for _, want := range tt.contains {
+				if !strings.Contains(html, want) {
+					t.Errorf("expected %s, got: %s", want, html)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(html, unwanted) {
+					t.Errorf("did not expect %s, got: %s", unwanted, html)
+				}
+			}
+		})
+	}
+}
+
+func TestDropdownItem_DangerStyleCarriesNonce(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := templ.WithNonce(context.Background(), "abc123")
+	if err := DropdownItem(Item{Label: "Delete", Danger: true}).Render(ctx, &buf); err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), `<style nonce="abc123">`) {
+		t.Errorf("expected nonce on danger style, got: %s", buf.String())
+	}
+}
+
+func TestDropdownDivider(t *testing.T) {
+	html := render(t, DropdownDivider())
+
+	if !strings.Contains(html, `<li role="separator"><hr></li>`) {
+		t.Errorf("expected separator item, got: %s", html)
+	}
+}
+
+func TestDropdownItem_DangerStyleDeferredToRegistry(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := assets.WithRegistry(context.Background())
+	for i := 0; i < 2; i++ {
+		if err := DropdownItem(Item{Label: "Delete", Danger: true}).Render(ctx, &buf); err != nil {
+			t.Fatalf("failed to render component: %v", err)
+		}
+	}
+
+	if strings.Contains(buf.String(), "<style") {
+		t.Errorf("expected no inline style, got: %s", buf.String())
+	}
+	styles := assets.FromContext(ctx).Pending().Styles
+	if len(styles) != 1 || styles[0].CSS != dangerCSS {
+		t.Errorf("expected the danger style to be required once, got %v", styles)
+	}
+}
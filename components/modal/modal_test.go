@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
 )
 
 func render(t *testing.T, component templ.Component) string {
@@ -186,3 +187,16 @@ func TestModalClose_Variant(t *testing.T) {
 		t.Error("expected secondary class")
 	}
 }
+
+func TestModal_RequiresHyperscript(t *testing.T) {
+	ctx := assets.WithRegistry(context.Background())
+	var buf bytes.Buffer
+	if err := Modal(Props{ID: "m"}).Render(ctx, &buf); err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+
+	pending := assets.FromContext(ctx).Pending()
+	if len(pending.Assets) != 1 || pending.Assets[0] != assets.Hyperscript {
+		t.Errorf("expected _hyperscript to be required, got %v", pending.Assets)
+	}
+}
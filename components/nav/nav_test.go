@@ -0,0 +1,213 @@
+package nav
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+func TestNav_RendersTwoLists(t *testing.T) {
+	html := render(t, Nav(Props{}))
+
+	if !strings.HasPrefix(html, "<nav>") {
+		t.Errorf("expected nav element, got: %s", html)
+	}
+	if strings.Count(html, "<ul>") != 2 {
+		t.Errorf("expected two lists, got: %s", html)
+	}
+}
+
+func TestNav_Brand(t *testing.T) {
+	html := render(t, Nav(Props{Brand: templ.Raw("<strong>Acme</strong>")}))
+
+	if !strings.Contains(html, "<ul><li><strong>Acme</strong></li></ul>") {
+		t.Errorf("expected brand in first list, got: %s", html)
+	}
+}
+
+func TestNav_LeftAndRightGroups(t *testing.T) {
+	html := render(t, Nav(Props{
+		Left:  []NavItem{{Label: "Docs", Href: "/docs"}},
+		Right: []NavItem{{Label: "Login", Href: "/login"}},
+	}))
+
+	docsIdx := strings.Index(html, `href="/docs"`)
+	secondListIdx := strings.LastIndex(html, "<ul>")
+	loginIdx := strings.Index(html, `href="/login"`)
+	if docsIdx == -1 || loginIdx == -1 {
+		t.Fatalf("expected both links, got: %s", html)
+	}
+	if !(docsIdx < secondListIdx && secondListIdx < loginIdx) {
+		t.Errorf("expected left links in first list and right links in second, got: %s", html)
+	}
+}
+
+func TestNav_ActiveFromCurrentPath(t *testing.T) {
+	html := render(t, Nav(Props{
+		CurrentPath: "/docs",
+		Left: []NavItem{
+			{Label: "Home", Href: "/"},
+			{Label: "Docs", Href: "/docs"},
+		},
+	}))
+
+	if !strings.Contains(html, `<a href="/docs" aria-current="page">Docs</a>`) {
+		t.Errorf("expected active docs link, got: %s", html)
+	}
+	if strings.Contains(html, `<a href="/" aria-current="page">`) {
+		t.Errorf("expected home link not to be active, got: %s", html)
+	}
+}
+
+func TestNav_ForcedActive(t *testing.T) {
+	html := render(t, Nav(Props{
+		Left: []NavItem{{Label: "Blog", Href: "/blog", Active: true}},
+	}))
+
+	if !strings.Contains(html, `aria-current="page"`) {
+		t.Errorf("expected forced active link, got: %s", html)
+	}
+}
+
+func TestNav_NestedDropdown(t *testing.T) {
+	html := render(t, Nav(Props{
+		CurrentPath: "/settings/profile",
+		Right: []NavItem{{
+			Label: "Settings",
+			Children: []NavItem{
+				{Label: "Profile", Href: "/settings/profile"},
+				{Label: "Billing", Href: "/settings/billing"},
+			},
+		}},
+	}))
+
+	expectations := []string{
+		`<details class="dropdown"><summary>Settings</summary><ul dir="rtl">`,
+		`<a href="/settings/profile" aria-current="page">Profile</a>`,
+		`<a href="/settings/billing">Billing</a>`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+}
+
+func TestNav_Boost(t *testing.T) {
+	html := render(t, Nav(Props{Boost: true}))
+
+	if !strings.Contains(html, `hx-boost="true"`) {
+		t.Errorf("expected hx-boost attribute, got: %s", html)
+	}
+}
+
+func TestNav_HtmxAttrs(t *testing.T) {
+	html := render(t, Nav(Props{Boost: true, Htmx: attrs.HtmxAttrs{Target: "main", Select: "main"}}))
+
+	for _, exp := range []string{`hx-target="main"`, `hx-select="main"`, `hx-boost="true"`} {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+
+	html = render(t, Nav(Props{Boost: true, Htmx: attrs.HtmxAttrs{Boost: attrs.FlagFalse}}))
+	if !strings.Contains(html, `hx-boost="false"`) {
+		t.Errorf("expected Htmx.Boost to win over Boost, got: %s", html)
+	}
+}
+
+func TestNav_Collapsible(t *testing.T) {
+	html := render(t, Nav(Props{
+		Collapsible: true,
+		Left:        []NavItem{{Label: "Docs", Href: "/docs"}},
+		Right: []NavItem{{
+			Label:    "More",
+			Children: []NavItem{{Label: "About", Href: "/about"}},
+		}},
+	}))
+
+	expectations := []string{
+		`<style>`,
+		`<li class="nav-wide"><a href="/docs">Docs</a></li>`,
+		`<li class="nav-narrow">`,
+		`aria-label="Menu"`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+	// Flattened links appear in both the full lists and the hamburger menu.
+	if strings.Count(html, `href="/docs"`) != 2 || strings.Count(html, `href="/about"`) != 2 {
+		t.Errorf("expected links duplicated into the collapsed menu, got: %s", html)
+	}
+}
+
+func TestNav_CollapseStyleCarriesNonce(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := templ.WithNonce(context.Background(), "abc123")
+	if err := Nav(Props{Collapsible: true}).Render(ctx, &buf); err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), `<style nonce="abc123">`) {
+		t.Errorf("expected nonce on collapse style, got: %s", buf.String())
+	}
+}
+
+func TestNav_NotCollapsibleByDefault(t *testing.T) {
+	html := render(t, Nav(Props{Left: []NavItem{{Label: "Docs", Href: "/docs"}}}))
+
+	if strings.Contains(html, "nav-narrow") || strings.Contains(html, "nav-wide") || strings.Contains(html, "<style>") {
+		t.Errorf("expected no collapse markup, got: %s", html)
+	}
+}
+
+func TestNav_IDClassAndAttrs(t *testing.T) {
+	html := render(t, Nav(Props{
+		ID:    "main-nav",
+		Class: "container",
+		Attrs: templ.Attributes{"data-testid": "nav"},
+	}))
+
+	if !strings.Contains(html, `id="main-nav"`) {
+		t.Errorf("expected id attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `class="container"`) {
+		t.Errorf("expected class attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `data-testid="nav"`) {
+		t.Errorf("expected data-testid attribute, got: %s", html)
+	}
+}
+
+func TestNav_CollapseStyleDeferredToRegistry(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := assets.WithRegistry(context.Background())
+	if err := Nav(Props{Collapsible: true}).Render(ctx, &buf); err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+
+	if strings.Contains(buf.String(), "<style") {
+		t.Errorf("expected no inline style, got: %s", buf.String())
+	}
+	styles := assets.FromContext(ctx).Pending().Styles
+	if len(styles) != 1 || styles[0].CSS != collapseCSS {
+		t.Errorf("expected the collapse style to be required, got %v", styles)
+	}
+}
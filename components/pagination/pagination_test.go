@@ -0,0 +1,253 @@
+package pagination
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+// labels returns the link labels with the current page wrapped in brackets
+// and disabled links suffixed with "!".
+func labels(links []link) string {
+	parts := make([]string, 0, len(links))
+	for _, l := range links {
+		switch {
+		case l.Current:
+			parts = append(parts, "["+l.Label+"]")
+		case l.Disabled:
+			parts = append(parts, l.Label+"!")
+		default:
+			parts = append(parts, l.Label)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestLinks(t *testing.T) {
+	tests := []struct {
+		name  string
+		props Props
+		want  string
+	}{
+		{
+			name:  "no items",
+			props: Props{Page: 1, Total: 0, PageSize: 10},
+			want:  "",
+		},
+		{
+			name:  "single page",
+			props: Props{Page: 1, Total: 10, PageSize: 10},
+			want:  "",
+		},
+		{
+			name:  "first of few",
+			props: Props{Page: 1, Total: 30, PageSize: 10},
+			want:  "«! ‹! [1] 2 3 › »",
+		},
+		{
+			name:  "last of few",
+			props: Props{Page: 3, Total: 30, PageSize: 10},
+			want:  "« ‹ 1 2 [3] ›! »!",
+		},
+		{
+			name:  "middle with ellipses",
+			props: Props{Page: 10, Total: 200, PageSize: 10},
+			want:  "« ‹ 1 … 8 9 [10] 11 12 … 20 › »",
+		},
+		{
+			name:  "single hidden page shown instead of ellipsis",
+			props: Props{Page: 5, Total: 90, PageSize: 10},
+			want:  "« ‹ 1 2 3 4 [5] 6 7 8 9 › »",
+		},
+		{
+			name:  "custom window",
+			props: Props{Page: 10, Total: 200, PageSize: 10, Window: 1},
+			want:  "« ‹ 1 … 9 [10] 11 … 20 › »",
+		},
+		{
+			name:  "partial last page",
+			props: Props{Page: 1, Total: 21, PageSize: 10},
+			want:  "«! ‹! [1] 2 3 › »",
+		},
+		{
+			name:  "page clamped to range",
+			props: Props{Page: 99, Total: 30, PageSize: 10},
+			want:  "« ‹ 1 2 [3] ›! »!",
+		},
+		{
+			name:  "page below range",
+			props: Props{Page: 0, Total: 30, PageSize: 10},
+			want:  "«! ‹! [1] 2 3 › »",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := labels(tt.props.links())
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPagination_RendersNothingForSinglePage(t *testing.T) {
+	html := render(t, Pagination(Props{Page: 1, Total: 5, PageSize: 10}))
+
+	if html != "" {
+		t.Errorf("expected empty output, got: %s", html)
+	}
+}
+
+func TestPagination_RendersNav(t *testing.T) {
+	html := render(t, Pagination(Props{Page: 2, Total: 30, PageSize: 10}))
+
+	expectations := []string{
+		`<nav aria-label="pagination">`,
+		`<a href="?page=2" aria-current="page">2</a>`,
+		`<a href="?page=1" aria-label="Previous page">‹</a>`,
+		`<a href="?page=3" aria-label="Next page">›</a>`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+}
+
+func TestPagination_DisabledBoundaryLinks(t *testing.T) {
+	html := render(t, Pagination(Props{Page: 1, Total: 30, PageSize: 10}))
+
+	if !strings.Contains(html, `<a aria-disabled="true" aria-label="First page">«</a>`) {
+		t.Errorf("expected disabled first link, got: %s", html)
+	}
+}
+
+func TestPagination_Ellipsis(t *testing.T) {
+	html := render(t, Pagination(Props{Page: 10, Total: 200, PageSize: 10}))
+
+	if strings.Count(html, `<span aria-hidden="true">…</span>`) != 2 {
+		t.Errorf("expected two ellipses, got: %s", html)
+	}
+}
+
+func TestPagination_CustomURL(t *testing.T) {
+	html := render(t, Pagination(Props{
+		Page:     1,
+		Total:    30,
+		PageSize: 10,
+		URL:      func(page int) string { return fmt.Sprintf("/users/page/%d", page) },
+	}))
+
+	if !strings.Contains(html, `href="/users/page/3"`) {
+		t.Errorf("expected custom URL, got: %s", html)
+	}
+}
+
+func TestPagination_HtmxSwapping(t *testing.T) {
+	html := render(t, Pagination(Props{
+		Page:     1,
+		Total:    30,
+		PageSize: 10,
+		Target:   "#results",
+		PushURL:  true,
+	}))
+
+	expectations := []string{
+		`hx-get="?page=2"`,
+		`hx-target="#results"`,
+		`hx-swap="innerHTML"`,
+		`hx-push-url="true"`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+}
+
+func TestPagination_CustomSwap(t *testing.T) {
+	html := render(t, Pagination(Props{Page: 1, Total: 30, PageSize: 10, Target: "#list", Swap: "outerHTML"}))
+
+	if !strings.Contains(html, `hx-swap="outerHTML"`) {
+		t.Errorf("expected custom swap, got: %s", html)
+	}
+	if strings.Contains(html, `hx-push-url`) {
+		t.Errorf("expected no hx-push-url, got: %s", html)
+	}
+}
+
+func TestPagination_HtmxAttrs(t *testing.T) {
+	html := render(t, Pagination(Props{
+		Page:     1,
+		Total:    30,
+		PageSize: 10,
+		Htmx:     attrs.HtmxAttrs{Target: "#results", Swap: "outerHTML", Indicator: "#spinner"},
+		Target:   "#ignored",
+		PushURL:  true,
+	}))
+
+	expectations := []string{
+		`hx-get="?page=2"`,
+		`hx-target="#results"`,
+		`hx-swap="outerHTML"`,
+		`hx-indicator="#spinner"`,
+		`hx-push-url="true"`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+	if strings.Contains(html, "#ignored") {
+		t.Errorf("expected Htmx.Target to win over Target, got: %s", html)
+	}
+}
+
+func TestPagination_HtmxRequestReplacesPageURL(t *testing.T) {
+	html := render(t, Pagination(Props{Page: 1, Total: 30, PageSize: 10, Htmx: attrs.HtmxAttrs{Post: "/search"}}))
+
+	if !strings.Contains(html, `hx-post="/search"`) || strings.Contains(html, "hx-get") {
+		t.Errorf("expected hx-post without hx-get, got: %s", html)
+	}
+}
+
+func TestPagination_NoHtmxWithoutTarget(t *testing.T) {
+	html := render(t, Pagination(Props{Page: 1, Total: 30, PageSize: 10}))
+
+	if strings.Contains(html, "hx-") {
+		t.Errorf("expected no HTMX attributes without target, got: %s", html)
+	}
+}
+
+func TestPagination_ClassAndAttrs(t *testing.T) {
+	html := render(t, Pagination(Props{
+		Page:     1,
+		Total:    30,
+		PageSize: 10,
+		Class:    "pager",
+		Attrs:    templ.Attributes{"id": "pager"},
+	}))
+
+	if !strings.Contains(html, `class="pager"`) {
+		t.Errorf("expected class attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `id="pager"`) {
+		t.Errorf("expected id attribute, got: %s", html)
+	}
+}
@@ -0,0 +1,179 @@
+package progress
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+func TestProgress_Determinate(t *testing.T) {
+	html := render(t, Progress(Props{Value: 42}))
+
+	if !strings.Contains(html, `<progress value="42" max="100"></progress>`) {
+		t.Errorf("expected determinate progress, got: %s", html)
+	}
+}
+
+func TestProgress_CustomMax(t *testing.T) {
+	html := render(t, Progress(Props{Value: 0.5, Max: 1}))
+
+	if !strings.Contains(html, `value="0.5" max="1"`) {
+		t.Errorf("expected custom max, got: %s", html)
+	}
+}
+
+func TestProgress_Indeterminate(t *testing.T) {
+	html := render(t, Progress(Props{Indeterminate: true, Value: 50}))
+
+	if !strings.Contains(html, `<progress></progress>`) {
+		t.Errorf("expected indeterminate progress without value, got: %s", html)
+	}
+}
+
+func TestProgress_LabelAndPercent(t *testing.T) {
+	html := render(t, Progress(Props{Label: "Uploading", Value: 3, Max: 8, ShowPercent: true}))
+
+	if !strings.Contains(html, `<label>Uploading <span>38%</span><progress`) {
+		t.Errorf("expected label with percentage, got: %s", html)
+	}
+}
+
+func TestProgress_PercentHiddenWhenIndeterminate(t *testing.T) {
+	html := render(t, Progress(Props{Indeterminate: true, ShowPercent: true}))
+
+	if strings.Contains(html, "%") || strings.Contains(html, "<label>") {
+		t.Errorf("expected no percentage for indeterminate progress, got: %s", html)
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		name  string
+		props Props
+		want  string
+	}{
+		{"zero", Props{Value: 0}, "0%"},
+		{"half", Props{Value: 50}, "50%"},
+		{"rounded", Props{Value: 1, Max: 3}, "33%"},
+		{"over max clamps", Props{Value: 150}, "100%"},
+		{"negative clamps", Props{Value: -5}, "0%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.props.percent(); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTrigger(t *testing.T) {
+	tests := []struct {
+		interval time.Duration
+		want     string
+	}{
+		{0, "every 1s"},
+		{2 * time.Second, "every 2s"},
+		{500 * time.Millisecond, "every 500ms"},
+		{1500 * time.Millisecond, "every 1500ms"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := (Props{Interval: tt.interval}).trigger(); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestProgress_Polling(t *testing.T) {
+	html := render(t, Progress(Props{
+		ID:       "job-1",
+		Value:    10,
+		PollURL:  "/jobs/1/progress",
+		Interval: 2 * time.Second,
+	}))
+
+	expectations := []string{
+		`id="job-1"`,
+		`hx-get="/jobs/1/progress"`,
+		`hx-trigger="every 2s"`,
+		`hx-swap="outerHTML"`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+}
+
+func TestProgress_HtmxAttrs(t *testing.T) {
+	html := render(t, Progress(Props{
+		ID:       "job-1",
+		PollURL:  "/jobs/1/progress",
+		Interval: 2 * time.Second,
+		Htmx:     attrs.HtmxAttrs{Trigger: "every 500ms", Indicator: "#spinner"},
+	}))
+
+	expectations := []string{
+		`hx-get="/jobs/1/progress"`,
+		`hx-trigger="every 500ms"`,
+		`hx-swap="outerHTML"`,
+		`hx-indicator="#spinner"`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+
+	done := render(t, Progress(Props{Htmx: attrs.HtmxAttrs{Get: "/jobs/1/progress"}, Done: true}))
+	if strings.Contains(done, "hx-") {
+		t.Errorf("expected no HTMX attributes once done, got: %s", done)
+	}
+}
+
+func TestProgress_DoneStopsPolling(t *testing.T) {
+	html := render(t, Progress(Props{
+		ID:      "job-1",
+		Value:   100,
+		PollURL: "/jobs/1/progress",
+		Done:    true,
+	}))
+
+	if strings.Contains(html, "hx-") {
+		t.Errorf("expected no polling attributes when done, got: %s", html)
+	}
+	if !strings.Contains(html, `id="job-1"`) {
+		t.Errorf("expected id to be kept, got: %s", html)
+	}
+}
+
+func TestProgress_ClassAndAttrs(t *testing.T) {
+	html := render(t, Progress(Props{
+		Class: "upload",
+		Attrs: templ.Attributes{"data-testid": "progress"},
+	}))
+
+	if !strings.Contains(html, `class="upload"`) {
+		t.Errorf("expected class attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `data-testid="progress"`) {
+		t.Errorf("expected data-testid attribute, got: %s", html)
+	}
+}
@@ -0,0 +1,107 @@
+package link
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+func TestLink(t *testing.T) {
+	html := render(t, Link(Props{Href: "/docs", Secondary: true}))
+	if !strings.Contains(html, `href="/docs"`) {
+		t.Errorf("expected href attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `class="secondary"`) {
+		t.Errorf("expected secondary class, got: %s", html)
+	}
+}
+
+func TestExternalLink(t *testing.T) {
+	html := render(t, ExternalLink(Props{Href: "https://example.com"}))
+	if !strings.Contains(html, `target="_blank"`) || !strings.Contains(html, `rel="noopener noreferrer"`) {
+		t.Errorf("expected new tab attributes, got: %s", html)
+	}
+}
+
+func TestButtonLink(t *testing.T) {
+	html := render(t, ButtonLink(Props{Href: "/signup", Htmx: attrs.HtmxAttrs{Boost: attrs.FlagTrue}}))
+	if !strings.Contains(html, `role="button"`) {
+		t.Errorf("expected button role, got: %s", html)
+	}
+	if !strings.Contains(html, `hx-boost="true"`) {
+		t.Errorf("expected hx-boost attribute, got: %s", html)
+	}
+}
+
+func TestHtmxOnEveryLink(t *testing.T) {
+	props := Props{
+		Href:      "/items",
+		Htmx:      attrs.HtmxAttrs{Get: "/items", Target: "#list"},
+		HxSwap:    "outerHTML",
+		HxPushURL: "true",
+	}
+	components := map[string]templ.Component{
+		"Link":         Link(props),
+		"ExternalLink": ExternalLink(props),
+		"ButtonLink":   ButtonLink(props),
+	}
+	for name, component := range components {
+		html := render(t, component)
+		for _, want := range []string{`hx-get="/items"`, `hx-target="#list"`, `hx-swap="outerHTML"`, `hx-push-url="true"`} {
+			if !strings.Contains(html, want) {
+				t.Errorf("%s: expected %s, got: %s", name, want, html)
+			}
+		}
+	}
+}
+
+func TestExternalLinkInvalidHtmxFailsRender(t *testing.T) {
+	var buf bytes.Buffer
+	err := ExternalLink(Props{Href: "https://example.com", Htmx: attrs.HtmxAttrs{Boost: "yes"}}).Render(context.Background(), &buf)
+	if err == nil || !strings.Contains(err.Error(), "hx-boost") {
+		t.Errorf("expected hx-boost error, got %v", err)
+	}
+}
+
+func TestTooltip(t *testing.T) {
+	components := map[string]func(Props) templ.Component{
+		"Link":         Link,
+		"ExternalLink": ExternalLink,
+		"ButtonLink":   ButtonLink,
+	}
+	for name, component := range components {
+		html := render(t, component(Props{Href: "/", Tooltip: "Home", TooltipPlacement: attrs.PlacementRight}))
+		if !strings.Contains(html, `data-tooltip="Home"`) || !strings.Contains(html, `data-placement="right"`) {
+			t.Errorf("%s: expected tooltip attributes, got: %s", name, html)
+		}
+	}
+}
+
+func TestTooltipOmittedWithoutText(t *testing.T) {
+	html := render(t, Link(Props{Href: "/", TooltipPlacement: attrs.PlacementRight}))
+	if strings.Contains(html, "data-tooltip") || strings.Contains(html, "data-placement") {
+		t.Errorf("expected no tooltip attributes, got: %s", html)
+	}
+}
+
+func TestTooltipInvalidPlacement(t *testing.T) {
+	var buf bytes.Buffer
+	err := Link(Props{Href: "/", Tooltip: "Home", TooltipPlacement: "diagonal"}).Render(context.Background(), &buf)
+	if err == nil {
+		t.Errorf("expected render error for invalid placement, got: %s", buf.String())
+	}
+}
@@ -0,0 +1,70 @@
+package typography
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+func TestTextTooltip(t *testing.T) {
+	props := TextProps{Tooltip: "Keyboard shortcut", TooltipPlacement: attrs.PlacementBottom}
+	components := map[string]templ.Component{
+		"<small":  Small(props),
+		"<strong": Strong(props),
+		"<em":     Em(props),
+		"<mark":   Mark(props),
+		"<del":    Del(props),
+		"<ins":    Ins(props),
+		"<sub":    Sub(props),
+		"<sup":    Sup(props),
+		"<abbr":   Abbr("Title", props),
+		"<kbd":    Kbd(props),
+		"<code":   Code(props),
+		"<pre":    Pre(props),
+	}
+	for tag, component := range components {
+		html := render(t, component)
+		if !strings.HasPrefix(html, tag) {
+			t.Errorf("expected %s element, got: %s", tag, html)
+		}
+		if !strings.Contains(html, `data-tooltip="Keyboard shortcut"`) || !strings.Contains(html, `data-placement="bottom"`) {
+			t.Errorf("%s: expected tooltip attributes, got: %s", tag, html)
+		}
+	}
+}
+
+func TestTextWithoutTooltip(t *testing.T) {
+	html := render(t, Strong(TextProps{Class: "lead"}))
+	if html != `<strong class="lead"></strong>` {
+		t.Errorf("expected plain strong element, got: %s", html)
+	}
+}
+
+func TestTooltipInvalidPlacement(t *testing.T) {
+	var buf bytes.Buffer
+	err := Kbd(TextProps{Tooltip: "Copy", TooltipPlacement: "diagonal"}).Render(context.Background(), &buf)
+	if err == nil {
+		t.Errorf("expected render error for invalid placement, got: %s", buf.String())
+	}
+}
+
+func TestHeading(t *testing.T) {
+	html := render(t, H2(HeadingProps{Class: "title"}))
+	if html != `<h2 class="title"></h2>` {
+		t.Errorf("expected h2 element, got: %s", html)
+	}
+}
@@ -9,9 +9,15 @@ import "github.com/a-h/templ"
 import templruntime "github.com/a-h/templ/runtime"
 
 import (
+	"github.com/markopolo123/pico_templ/components/accordion"
+	"github.com/markopolo123/pico_templ/components/breadcrumb"
 	"github.com/markopolo123/pico_templ/components/button"
 	"github.com/markopolo123/pico_templ/components/card"
+	"github.com/markopolo123/pico_templ/components/dropdown"
 	"github.com/markopolo123/pico_templ/components/modal"
+	"github.com/markopolo123/pico_templ/components/nav"
+	"github.com/markopolo123/pico_templ/components/pagination"
+	"github.com/markopolo123/pico_templ/components/progress"
 	"github.com/markopolo123/pico_templ/docs/templates"
 )
 
@@ -48,7 +54,7 @@ func Components() templ.Component {
 				}()
 			}
 			ctx = templ.InitializeContext(ctx)
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<h1>Components</h1><p class=\"lead\">pico_templ provides ready-to-use templ components that wrap Pico CSS patterns with built-in HTMX and _hyperscript support.</p><nav><ul><li><a href=\"#button\">Button</a></li><li><a href=\"#card\">Card</a></li><li><a href=\"#modal\">Modal</a></li><li><a href=\"#coming-soon\">Coming Soon</a></li></ul></nav><hr><!-- Button Component --> <section id=\"button\"><h2>Button</h2><p>The Button component renders a styled button element with Pico CSS classes and full HTMX attribute support. It supports primary, secondary, and contrast variants, plus an outline modifier.</p><h3>Basic Examples</h3><div class=\"grid\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<h1>Components</h1><p class=\"lead\">pico_templ provides ready-to-use templ components that wrap Pico CSS patterns with built-in HTMX and _hyperscript support.</p><nav><ul><li><a href=\"#button\">Button</a></li><li><a href=\"#card\">Card</a></li><li><a href=\"#modal\">Modal</a></li><li><a href=\"#accordion\">Accordion</a></li><li><a href=\"#dropdown\">Dropdown</a></li><li><a href=\"#navigation\">Navigation</a></li><li><a href=\"#progress\">Progress</a></li></ul></nav><hr><!-- Button Component --> <section id=\"button\"><h2>Button</h2><p>The Button component renders a styled button element with Pico CSS classes and full HTMX attribute support. It supports primary, secondary, and contrast variants, plus an outline modifier.</p><h3>Basic Examples</h3><div class=\"grid\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -133,40 +139,51 @@ func Components() templ.Component {
 
 // With HTMX
 @button.Button(button.Props{
-    Text:     "Load Data",
-    HxGet:    "/api/data",
-    HxTarget: "#result",
-    HxSwap:   "innerHTML",
+    Text: "Load Data",
+    Htmx: attrs.HtmxAttrs{Get: "/api/data", Target: "#result", Swap: "innerHTML"},
 })`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 85, Col: 3}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 92, Col: 3}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var3))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, "</code></pre><h3>Props Reference</h3><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Text</code></td><td>string</td><td>\"\"</td><td>Button text content</td></tr><tr><td><code>Type</code></td><td>string</td><td>\"button\"</td><td>HTML button type: button, submit, reset</td></tr><tr><td><code>Variant</code></td><td>string</td><td>Primary</td><td>Style variant: Primary (default), Secondary, Contrast</td></tr><tr><td><code>Outline</code></td><td>bool</td><td>false</td><td>Render as outline style</td></tr><tr><td><code>Disabled</code></td><td>bool</td><td>false</td><td>Disabled state</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr><tr><td><code>HxGet</code></td><td>string</td><td>\"\"</td><td>HTMX hx-get attribute</td></tr><tr><td><code>HxPost</code></td><td>string</td><td>\"\"</td><td>HTMX hx-post attribute</td></tr><tr><td><code>HxPut</code></td><td>string</td><td>\"\"</td><td>HTMX hx-put attribute</td></tr><tr><td><code>HxDelete</code></td><td>string</td><td>\"\"</td><td>HTMX hx-delete attribute</td></tr><tr><td><code>HxPatch</code></td><td>string</td><td>\"\"</td><td>HTMX hx-patch attribute</td></tr><tr><td><code>HxTarget</code></td><td>string</td><td>\"\"</td><td>HTMX hx-target attribute</td></tr><tr><td><code>HxSwap</code></td><td>string</td><td>\"\"</td><td>HTMX hx-swap attribute</td></tr><tr><td><code>HxTrigger</code></td><td>string</td><td>\"\"</td><td>HTMX hx-trigger attribute</td></tr><tr><td><code>Attrs</code></td><td>templ.Attributes</td><td>nil</td><td>Additional arbitrary attributes</td></tr></tbody></table></figure><h3>Variant Constants</h3><pre><code>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, "</code></pre><h3>Props Reference</h3><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Text</code></td><td>string</td><td>\"\"</td><td>Button text content</td></tr><tr><td><code>Type</code></td><td>string</td><td>\"button\"</td><td>HTML button type: button, submit, reset</td></tr><tr><td><code>Variant</code></td><td>string</td><td>Primary</td><td>Style variant: Primary (default), Secondary, Contrast</td></tr><tr><td><code>Outline</code></td><td>bool</td><td>false</td><td>Render as outline style</td></tr><tr><td><code>Disabled</code></td><td>bool</td><td>false</td><td>Disabled state</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr><tr><td><code>Htmx</code></td><td>attrs.HtmxAttrs</td><td>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var4 string
-			templ_7745c5c3_Var4, templ_7745c5c3_Err = templ.JoinStringErrs(`const (
+			templ_7745c5c3_Var4, templ_7745c5c3_Err = templ.JoinStringErrs("{}")
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 146, Col: 17}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var4))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "</td><td>HTMX attributes (the flat HxGet, HxPost, … fields are deprecated aliases)</td></tr><tr><td><code>Attrs</code></td><td>templ.Attributes</td><td>nil</td><td>Additional arbitrary attributes</td></tr></tbody></table></figure><h3>Variant Constants</h3><pre><code>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var5 string
+			templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinStringErrs(`const (
     Primary   = ""          // Default primary style
     Secondary = "secondary" // Secondary style
     Contrast  = "contrast"  // Contrast style
 )`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 200, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 165, Col: 2}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var4))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "</code></pre></section><hr><!-- Card Component --> <section id=\"card\"><h2>Card</h2><p>The Card component provides a container for grouping related content with optional header and footer sections. It renders as a Pico CSS <code>&lt;article&gt;</code> element.</p><h3>Basic Card</h3>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "</code></pre></section><hr><!-- Card Component --> <section id=\"card\"><h2>Card</h2><p>The Card component provides a container for grouping related content with optional header and footer sections. It renders as a Pico CSS <code>&lt;article&gt;</code> element.</p><h3>Basic Card</h3>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Var5 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_Var6 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 				if !templ_7745c5c3_IsBuffer {
@@ -178,21 +195,21 @@ func Components() templ.Component {
 					}()
 				}
 				ctx = templ.InitializeContext(ctx)
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "<p>This is a basic card with just body content. Cards are great for grouping related information together.</p>")
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "<p>This is a basic card with just body content. Cards are great for grouping related information together.</p>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
 				return nil
 			})
-			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var5), templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var6), templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "<h3>Card with Header and Footer</h3>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "<h3>Card with Header and Footer</h3>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Var6 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_Var7 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 				if !templ_7745c5c3_IsBuffer {
@@ -204,7 +221,7 @@ func Components() templ.Component {
 					}()
 				}
 				ctx = templ.InitializeContext(ctx)
-				templ_7745c5c3_Var7 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_Var8 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 					if !templ_7745c5c3_IsBuffer {
@@ -216,21 +233,21 @@ func Components() templ.Component {
 						}()
 					}
 					ctx = templ.InitializeContext(ctx)
-					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "<strong>Card Title</strong>")
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "<strong>Card Title</strong>")
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
 					return nil
 				})
-				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var7), templ_7745c5c3_Buffer)
+				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var8), templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, " <p>This card has a header, body content, and a footer with actions. The header and footer components are optional and can be used independently.</p>")
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, " <p>This card has a header, body content, and a footer with actions. The header and footer components are optional and can be used independently.</p>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Var8 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_Var9 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 					if !templ_7745c5c3_IsBuffer {
@@ -246,7 +263,7 @@ func Components() templ.Component {
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
-					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, " ")
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, " ")
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
@@ -256,21 +273,21 @@ func Components() templ.Component {
 					}
 					return nil
 				})
-				templ_7745c5c3_Err = card.CardFooter(card.FooterProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var8), templ_7745c5c3_Buffer)
+				templ_7745c5c3_Err = card.CardFooter(card.FooterProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var9), templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
 				return nil
 			})
-			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var6), templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var7), templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "<h3>Card Grid</h3><div class=\"grid\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "<h3>Card Grid</h3><div class=\"grid\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Var9 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_Var10 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 				if !templ_7745c5c3_IsBuffer {
@@ -282,7 +299,7 @@ func Components() templ.Component {
 					}()
 				}
 				ctx = templ.InitializeContext(ctx)
-				templ_7745c5c3_Var10 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_Var11 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 					if !templ_7745c5c3_IsBuffer {
@@ -294,27 +311,27 @@ func Components() templ.Component {
 						}()
 					}
 					ctx = templ.InitializeContext(ctx)
-					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "<strong>Feature One</strong>")
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "<strong>Feature One</strong>")
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
 					return nil
 				})
-				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var10), templ_7745c5c3_Buffer)
+				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var11), templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, " <p>Cards work great in grids for feature showcases or dashboards.</p>")
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, " <p>Cards work great in grids for feature showcases or dashboards.</p>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
 				return nil
 			})
-			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var9), templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var10), templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Var11 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_Var12 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 				if !templ_7745c5c3_IsBuffer {
@@ -326,7 +343,7 @@ func Components() templ.Component {
 					}()
 				}
 				ctx = templ.InitializeContext(ctx)
-				templ_7745c5c3_Var12 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_Var13 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 					if !templ_7745c5c3_IsBuffer {
@@ -338,27 +355,27 @@ func Components() templ.Component {
 						}()
 					}
 					ctx = templ.InitializeContext(ctx)
-					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, "<strong>Feature Two</strong>")
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 17, "<strong>Feature Two</strong>")
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
 					return nil
 				})
-				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var12), templ_7745c5c3_Buffer)
+				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var13), templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 17, " <p>Each card can have its own header, content, and footer sections.</p>")
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 18, " <p>Each card can have its own header, content, and footer sections.</p>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
 				return nil
 			})
-			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var11), templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var12), templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Var13 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_Var14 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 				if !templ_7745c5c3_IsBuffer {
@@ -370,7 +387,7 @@ func Components() templ.Component {
 					}()
 				}
 				ctx = templ.InitializeContext(ctx)
-				templ_7745c5c3_Var14 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_Var15 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 					if !templ_7745c5c3_IsBuffer {
@@ -382,32 +399,32 @@ func Components() templ.Component {
 						}()
 					}
 					ctx = templ.InitializeContext(ctx)
-					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 18, "<strong>Feature Three</strong>")
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 19, "<strong>Feature Three</strong>")
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
 					return nil
 				})
-				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var14), templ_7745c5c3_Buffer)
+				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var15), templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 19, " <p>Mix and match components to build the UI you need.</p>")
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 20, " <p>Mix and match components to build the UI you need.</p>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
 				return nil
 			})
-			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var13), templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var14), templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 20, "</div><h3>Usage</h3><pre><code>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 21, "</div><h3>Usage</h3><pre><code>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			var templ_7745c5c3_Var15 string
-			templ_7745c5c3_Var15, templ_7745c5c3_Err = templ.JoinStringErrs(`import "github.com/markopolo123/pico_templ/components/card"
+			var templ_7745c5c3_Var16 string
+			templ_7745c5c3_Var16, templ_7745c5c3_Err = templ.JoinStringErrs(`import "github.com/markopolo123/pico_templ/components/card"
 
 // Basic card
 @card.Card(card.Props{}) {
@@ -430,13 +447,13 @@ func Components() templ.Component {
     <p>Styled card content</p>
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 272, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 237, Col: 2}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var15))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var16))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 21, "</code></pre><h3>Props Reference</h3><h4>Card Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr><tr><td><code>Attrs</code></td><td>templ.Attributes</td><td>nil</td><td>Additional arbitrary attributes</td></tr></tbody></table></figure><h4>CardHeader Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr></tbody></table></figure><h4>CardFooter Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr></tbody></table></figure></section><hr><!-- Modal Component --> <section id=\"modal\"><h2>Modal</h2><p>The Modal component provides a dialog system using the native HTML <code>&lt;dialog&gt;</code> element with Pico CSS styling. It includes _hyperscript for click-outside-to-close functionality and helper components for triggers, headers, and footers.</p><h3>Basic Modal</h3>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 22, "</code></pre><h3>Props Reference</h3><h4>Card Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr><tr><td><code>Attrs</code></td><td>templ.Attributes</td><td>nil</td><td>Additional arbitrary attributes</td></tr></tbody></table></figure><h4>CardHeader Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr></tbody></table></figure><h4>CardFooter Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr></tbody></table></figure></section><hr><!-- Modal Component --> <section id=\"modal\"><h2>Modal</h2><p>The Modal component provides a dialog system using the native HTML <code>&lt;dialog&gt;</code> element with Pico CSS styling. It includes _hyperscript for click-outside-to-close functionality and helper components for triggers, headers, and footers.</p><h3>Basic Modal</h3>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -444,7 +461,7 @@ func Components() templ.Component {
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Var16 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_Var17 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 				if !templ_7745c5c3_IsBuffer {
@@ -460,11 +477,11 @@ func Components() templ.Component {
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 22, " <p>This is a basic modal dialog. Click outside or the X button to close it.</p>")
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 23, " <p>This is a basic modal dialog. Click outside or the X button to close it.</p>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Var17 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_Var18 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 					if !templ_7745c5c3_IsBuffer {
@@ -482,17 +499,17 @@ func Components() templ.Component {
 					}
 					return nil
 				})
-				templ_7745c5c3_Err = modal.ModalFooter(modal.FooterProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var17), templ_7745c5c3_Buffer)
+				templ_7745c5c3_Err = modal.ModalFooter(modal.FooterProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var18), templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
 				return nil
 			})
-			templ_7745c5c3_Err = modal.Modal(modal.Props{ID: "demo-modal-basic"}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var16), templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = modal.Modal(modal.Props{ID: "demo-modal-basic"}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var17), templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 23, "<h3>Modal with Form</h3>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 24, "<h3>Modal with Form</h3>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -500,7 +517,7 @@ func Components() templ.Component {
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Var18 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_Var19 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 				if !templ_7745c5c3_IsBuffer {
@@ -516,11 +533,11 @@ func Components() templ.Component {
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 24, " <form><label for=\"modal-name\">Name <input type=\"text\" id=\"modal-name\" name=\"name\" placeholder=\"Your name\"></label> <label for=\"modal-email\">Email <input type=\"email\" id=\"modal-email\" name=\"email\" placeholder=\"your@email.com\"></label> <label for=\"modal-message\">Message <textarea id=\"modal-message\" name=\"message\" placeholder=\"Your message...\"></textarea></label></form>")
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 25, " <form><label for=\"modal-name\">Name <input type=\"text\" id=\"modal-name\" name=\"name\" placeholder=\"Your name\"></label> <label for=\"modal-email\">Email <input type=\"email\" id=\"modal-email\" name=\"email\" placeholder=\"your@email.com\"></label> <label for=\"modal-message\">Message <textarea id=\"modal-message\" name=\"message\" placeholder=\"Your message...\"></textarea></label></form>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Var19 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_Var20 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 					if !templ_7745c5c3_IsBuffer {
@@ -536,7 +553,7 @@ func Components() templ.Component {
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
-					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 25, " ")
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 26, " ")
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
@@ -546,17 +563,17 @@ func Components() templ.Component {
 					}
 					return nil
 				})
-				templ_7745c5c3_Err = modal.ModalFooter(modal.FooterProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var19), templ_7745c5c3_Buffer)
+				templ_7745c5c3_Err = modal.ModalFooter(modal.FooterProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var20), templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
 				return nil
 			})
-			templ_7745c5c3_Err = modal.Modal(modal.Props{ID: "demo-modal-form"}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var18), templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = modal.Modal(modal.Props{ID: "demo-modal-form"}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var19), templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 26, "<h3>Confirmation Modal</h3>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 27, "<h3>Confirmation Modal</h3>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -564,7 +581,7 @@ func Components() templ.Component {
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Var20 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_Var21 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 				if !templ_7745c5c3_IsBuffer {
@@ -580,11 +597,11 @@ func Components() templ.Component {
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 27, " <p>Are you sure you want to delete this item? This action cannot be undone.</p>")
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 28, " <p>Are you sure you want to delete this item? This action cannot be undone.</p>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Var21 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_Var22 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 					if !templ_7745c5c3_IsBuffer {
@@ -600,7 +617,7 @@ func Components() templ.Component {
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
-					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 28, " ")
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 29, " ")
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
@@ -610,22 +627,22 @@ func Components() templ.Component {
 					}
 					return nil
 				})
-				templ_7745c5c3_Err = modal.ModalFooter(modal.FooterProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var21), templ_7745c5c3_Buffer)
+				templ_7745c5c3_Err = modal.ModalFooter(modal.FooterProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var22), templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
 				return nil
 			})
-			templ_7745c5c3_Err = modal.Modal(modal.Props{ID: "demo-modal-confirm"}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var20), templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = modal.Modal(modal.Props{ID: "demo-modal-confirm"}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var21), templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 29, "<h3>Usage</h3><pre><code>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 30, "<h3>Usage</h3><pre><code>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			var templ_7745c5c3_Var22 string
-			templ_7745c5c3_Var22, templ_7745c5c3_Err = templ.JoinStringErrs(`import "github.com/markopolo123/pico_templ/components/modal"
+			var templ_7745c5c3_Var23 string
+			templ_7745c5c3_Var23, templ_7745c5c3_Err = templ.JoinStringErrs(`import "github.com/markopolo123/pico_templ/components/modal"
 
 // Basic modal with trigger
 @modal.ModalTrigger(modal.TriggerProps{
@@ -657,18 +674,18 @@ func Components() templ.Component {
     Text:    "Close",
 })`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 429, Col: 3}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 394, Col: 3}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var22))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var23))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 30, "</code></pre><h3>Props Reference</h3><h4>Modal Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>ID</code></td><td>string</td><td><strong>Required</strong></td><td>Unique identifier for the modal (used for targeting)</td></tr><tr><td><code>Open</code></td><td>bool</td><td>false</td><td>Initial open state</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr><tr><td><code>Attrs</code></td><td>templ.Attributes</td><td>nil</td><td>Additional arbitrary attributes</td></tr></tbody></table></figure><h4>ModalHeader Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Title</code></td><td>string</td><td>\"\"</td><td>Modal title displayed in header</td></tr><tr><td><code>ShowClose</code></td><td>bool</td><td>true when Title set</td><td>Show X close button in header</td></tr></tbody></table></figure><h4>ModalFooter Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr></tbody></table></figure><h4>ModalTrigger Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>ModalID</code></td><td>string</td><td><strong>Required</strong></td><td>ID of the modal to open (without #)</td></tr><tr><td><code>Text</code></td><td>string</td><td>\"\"</td><td>Button text (or use children for custom content)</td></tr><tr><td><code>Variant</code></td><td>string</td><td>\"\"</td><td>Button variant (secondary, contrast, outline)</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr></tbody></table></figure><h4>ModalClose Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>ModalID</code></td><td>string</td><td>\"\"</td><td>ID of modal to close (if empty, closes parent dialog)</td></tr><tr><td><code>Text</code></td><td>string</td><td>\"\"</td><td>Button text (or use children for custom content)</td></tr><tr><td><code>Variant</code></td><td>string</td><td>\"\"</td><td>Button variant (secondary, contrast, outline)</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr></tbody></table></figure><h3>How It Works</h3><p>The Modal component uses the native HTML <code>&lt;dialog&gt;</code> element which provides built-in accessibility features like focus trapping and escape-to-close. The _hyperscript integration adds click-outside-to-close functionality:</p><pre><code>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 31, "</code></pre><h3>Props Reference</h3><h4>Modal Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>ID</code></td><td>string</td><td><strong>Required</strong></td><td>Unique identifier for the modal (used for targeting)</td></tr><tr><td><code>Open</code></td><td>bool</td><td>false</td><td>Initial open state</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr><tr><td><code>Attrs</code></td><td>templ.Attributes</td><td>nil</td><td>Additional arbitrary attributes</td></tr></tbody></table></figure><h4>ModalHeader Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Title</code></td><td>string</td><td>\"\"</td><td>Modal title displayed in header</td></tr><tr><td><code>ShowClose</code></td><td>bool</td><td>true when Title set</td><td>Show X close button in header</td></tr></tbody></table></figure><h4>ModalFooter Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr></tbody></table></figure><h4>ModalTrigger Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>ModalID</code></td><td>string</td><td><strong>Required</strong></td><td>ID of the modal to open (without #)</td></tr><tr><td><code>Text</code></td><td>string</td><td>\"\"</td><td>Button text (or use children for custom content)</td></tr><tr><td><code>Variant</code></td><td>string</td><td>\"\"</td><td>Button variant (secondary, contrast, outline)</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr></tbody></table></figure><h4>ModalClose Props</h4><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>ModalID</code></td><td>string</td><td>\"\"</td><td>ID of modal to close (if empty, closes parent dialog)</td></tr><tr><td><code>Text</code></td><td>string</td><td>\"\"</td><td>Button text (or use children for custom content)</td></tr><tr><td><code>Variant</code></td><td>string</td><td>\"\"</td><td>Button variant (secondary, contrast, outline)</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr></tbody></table></figure><h3>How It Works</h3><p>The Modal component uses the native HTML <code>&lt;dialog&gt;</code> element which provides built-in accessibility features like focus trapping and escape-to-close. The _hyperscript integration adds click-outside-to-close functionality:</p><pre><code>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			var templ_7745c5c3_Var23 string
-			templ_7745c5c3_Var23, templ_7745c5c3_Err = templ.JoinStringErrs(`// The modal uses this _hyperscript for click-outside-to-close
+			var templ_7745c5c3_Var24 string
+			templ_7745c5c3_Var24, templ_7745c5c3_Err = templ.JoinStringErrs(`// The modal uses this _hyperscript for click-outside-to-close
 _="on click if event.target === me call me.close()"
 
 // ModalTrigger uses showModal() to open properly
@@ -677,17 +694,17 @@ _="on click call #modal-id.showModal()"
 // ModalClose uses close() to dismiss
 _="on click call closest <dialog/>.close()"`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 612, Col: 44}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 577, Col: 44}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var23))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var24))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 31, "</code></pre></section><hr><!-- Coming Soon --> <section id=\"coming-soon\"><h2>Coming Soon</h2><p>The following components are planned for future releases:</p><div class=\"grid\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 32, "</code></pre></section><hr><!-- Accordion Component --> <section id=\"accordion\"><h2>Accordion</h2><p>The Accordion component groups collapsible <code>&lt;details&gt;</code> sections. Set <code>Exclusive</code> to keep at most one item open, and <code>LazyURL</code> to fetch an item's body with HTMX the first time it opens.</p><h3>Example</h3>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Var24 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_Var25 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 				if !templ_7745c5c3_IsBuffer {
@@ -699,7 +716,7 @@ _="on click call closest <dialog/>.close()"`)
 					}()
 				}
 				ctx = templ.InitializeContext(ctx)
-				templ_7745c5c3_Var25 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_Var26 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 					if !templ_7745c5c3_IsBuffer {
@@ -711,38 +728,20 @@ _="on click call closest <dialog/>.close()"`)
 						}()
 					}
 					ctx = templ.InitializeContext(ctx)
-					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 32, "<strong>Accordion</strong>")
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 33, "<p>A minimal CSS framework that styles semantic HTML without classes.</p>")
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
 					return nil
 				})
-				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var25), templ_7745c5c3_Buffer)
+				templ_7745c5c3_Err = accordion.AccordionItem(accordion.ItemProps{Title: "What is Pico CSS?", Open: true}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var26), templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 33, " <p>Collapsible content sections using the <code>&lt;details&gt;</code> element with smooth animations.</p>")
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 34, " ")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				return nil
-			})
-			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var24), templ_7745c5c3_Buffer)
-			if templ_7745c5c3_Err != nil {
-				return templ_7745c5c3_Err
-			}
-			templ_7745c5c3_Var26 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
-				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
-				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
-				if !templ_7745c5c3_IsBuffer {
-					defer func() {
-						templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
-						if templ_7745c5c3_Err == nil {
-							templ_7745c5c3_Err = templ_7745c5c3_BufErr
-						}
-					}()
-				}
-				ctx = templ.InitializeContext(ctx)
 				templ_7745c5c3_Var27 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
@@ -755,115 +754,203 @@ _="on click call closest <dialog/>.close()"`)
 						}()
 					}
 					ctx = templ.InitializeContext(ctx)
-					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 34, "<strong>Dropdown</strong>")
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 35, "<p>No. Accordions use the native <code>&lt;details&gt;</code> element; _hyperscript only adds exclusive mode.</p>")
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
 					}
 					return nil
 				})
-				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var27), templ_7745c5c3_Buffer)
-				if templ_7745c5c3_Err != nil {
-					return templ_7745c5c3_Err
-				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 35, " <p>Dropdown menus and select-like components with keyboard navigation.</p>")
+				templ_7745c5c3_Err = accordion.AccordionItem(accordion.ItemProps{Title: "Does it need JavaScript?"}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var27), templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
 				return nil
 			})
-			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var26), templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = accordion.Accordion(accordion.Props{Exclusive: true}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var25), templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Var28 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
-				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
-				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
-				if !templ_7745c5c3_IsBuffer {
-					defer func() {
-						templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
-						if templ_7745c5c3_Err == nil {
-							templ_7745c5c3_Err = templ_7745c5c3_BufErr
-						}
-					}()
-				}
-				ctx = templ.InitializeContext(ctx)
-				templ_7745c5c3_Var29 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
-					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
-					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
-					if !templ_7745c5c3_IsBuffer {
-						defer func() {
-							templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
-							if templ_7745c5c3_Err == nil {
-								templ_7745c5c3_Err = templ_7745c5c3_BufErr
-							}
-						}()
-					}
-					ctx = templ.InitializeContext(ctx)
-					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 36, "<strong>Nav</strong>")
-					if templ_7745c5c3_Err != nil {
-						return templ_7745c5c3_Err
-					}
-					return nil
-				})
-				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var29), templ_7745c5c3_Buffer)
-				if templ_7745c5c3_Err != nil {
-					return templ_7745c5c3_Err
-				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 37, " <p>Navigation components including navbars, breadcrumbs, and pagination.</p>")
-				if templ_7745c5c3_Err != nil {
-					return templ_7745c5c3_Err
-				}
-				return nil
-			})
-			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var28), templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 36, "<h3>Usage</h3><pre><code>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Var30 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
-				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
-				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
-				if !templ_7745c5c3_IsBuffer {
-					defer func() {
-						templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
-						if templ_7745c5c3_Err == nil {
-							templ_7745c5c3_Err = templ_7745c5c3_BufErr
-						}
-					}()
-				}
-				ctx = templ.InitializeContext(ctx)
-				templ_7745c5c3_Var31 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
-					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
-					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
-					if !templ_7745c5c3_IsBuffer {
-						defer func() {
-							templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
-							if templ_7745c5c3_Err == nil {
-								templ_7745c5c3_Err = templ_7745c5c3_BufErr
-							}
-						}()
-					}
-					ctx = templ.InitializeContext(ctx)
-					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 38, "<strong>Progress</strong>")
-					if templ_7745c5c3_Err != nil {
-						return templ_7745c5c3_Err
-					}
-					return nil
-				})
-				templ_7745c5c3_Err = card.CardHeader(card.HeaderProps{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var31), templ_7745c5c3_Buffer)
-				if templ_7745c5c3_Err != nil {
-					return templ_7745c5c3_Err
-				}
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 39, " <p>Progress bars and loading indicators with HTMX integration for real-time updates.</p>")
-				if templ_7745c5c3_Err != nil {
-					return templ_7745c5c3_Err
-				}
-				return nil
-			})
-			templ_7745c5c3_Err = card.Card(card.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var30), templ_7745c5c3_Buffer)
+			var templ_7745c5c3_Var28 string
+			templ_7745c5c3_Var28, templ_7745c5c3_Err = templ.JoinStringErrs(`import "github.com/markopolo123/pico_templ/components/accordion"
+
+@accordion.Accordion(accordion.Props{Exclusive: true}) {
+    @accordion.AccordionItem(accordion.ItemProps{Title: "First", Open: true}) {
+        <p>Shown on load.</p>
+    }
+    // The body is fetched with hx-get the first time the item is opened
+    @accordion.AccordionItem(accordion.ItemProps{Title: "Details", LazyURL: "/details"})
+}`)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 609, Col: 2}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var28))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 37, "</code></pre></section><hr><!-- Dropdown Component --> <section id=\"dropdown\"><h2>Dropdown</h2><p>The Dropdown component renders a Pico CSS <code>details.dropdown</code> menu with keyboard navigation. Items can be links or HTMX requests, and setting <code>Name</code> turns the menu into a select backed by a hidden input.</p><h3>Example</h3><div class=\"grid\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = dropdown.Dropdown(dropdown.Props{
+				Label: "Actions",
+				Items: []dropdown.Item{
+					{Label: "Edit", Href: "#dropdown"},
+					{Label: "Archive", Disabled: true},
+					{Label: "Delete", Href: "#dropdown", Danger: true},
+				},
+			}).Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = dropdown.Dropdown(dropdown.Props{
+				Label: "Choose a size",
+				Name:  "size",
+				Items: []dropdown.Item{
+					{Label: "Small", Value: "s"},
+					{Label: "Medium", Value: "m"},
+					{Label: "Large", Value: "l"},
+				},
+			}).Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 38, "</div><h3>Usage</h3><pre><code>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var29 string
+			templ_7745c5c3_Var29, templ_7745c5c3_Err = templ.JoinStringErrs(`import "github.com/markopolo123/pico_templ/components/dropdown"
+
+@dropdown.Dropdown(dropdown.Props{
+    Label:  "Actions",
+    Button: true,
+    Items: []dropdown.Item{
+        {Label: "Edit", Href: "/edit"},
+        {Label: "Delete", Method: dropdown.Delete, URL: "/items/1", Target: "#items", Danger: true},
+    },
+})
+
+// Select mode: the chosen Value is written to a hidden input named size
+@dropdown.Dropdown(dropdown.Props{
+    Label: "Size",
+    Name:  "size",
+    Items: []dropdown.Item{{Label: "Small", Value: "s"}, {Label: "Large", Value: "l"}},
+})`)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 660, Col: 3}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var29))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 39, "</code></pre></section><hr><!-- Navigation Components --> <section id=\"navigation\"><h2>Navigation</h2><p>The nav, breadcrumb and pagination packages cover site navigation. Nav marks the link matching <code>CurrentPath</code> as current, Breadcrumb can emit schema.org JSON-LD, and Pagination swaps pages with HTMX when <code>Target</code> is set.</p><h3>Nav</h3>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = nav.Nav(nav.Props{
+				Left:        []nav.NavItem{{Label: "Home", Href: "/"}},
+				Right:       []nav.NavItem{{Label: "Docs", Href: "/pico_templ/components.html"}, {Label: "About", Href: "/about"}},
+				CurrentPath: "/pico_templ/components.html",
+			}).Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 40, "<h3>Breadcrumb</h3>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = breadcrumb.Breadcrumb(breadcrumb.Props{Crumbs: []breadcrumb.Crumb{
+				{Label: "Home", Href: "/"},
+				{Label: "Docs", Href: "/pico_templ/"},
+				{Label: "Components"},
+			}}).Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 41, "<h3>Pagination</h3>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = pagination.Pagination(pagination.Props{Page: 4, Total: 200, PageSize: 20}).Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 42, "<h3>Usage</h3><pre><code>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var30 string
+			templ_7745c5c3_Var30, templ_7745c5c3_Err = templ.JoinStringErrs(`import (
+    "github.com/markopolo123/pico_templ/components/breadcrumb"
+    "github.com/markopolo123/pico_templ/components/nav"
+    "github.com/markopolo123/pico_templ/components/pagination"
+)
+
+@nav.Nav(nav.Props{
+    Left:        []nav.NavItem{{Label: "Home", Href: "/"}},
+    Right:       []nav.NavItem{{Label: "Docs", Href: "/docs"}},
+    CurrentPath: r.URL.Path,
+    Boost:       true,
+})
+
+@breadcrumb.Breadcrumb(breadcrumb.Props{
+    Crumbs:         []breadcrumb.Crumb{{Label: "Home", Href: "/"}, {Label: "Components"}},
+    StructuredData: true,
+    BaseURL:        "https://example.com",
+})
+
+// Swap #results with the requested page and push its URL
+@pagination.Pagination(pagination.Props{
+    Page: page, Total: total, PageSize: 20,
+    Target: "#results", PushURL: true,
+})`)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 713, Col: 3}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var30))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 43, "</code></pre></section><hr><!-- Progress Component --> <section id=\"progress\"><h2>Progress</h2><p>The Progress component renders a labelled <code>&lt;progress&gt;</code> bar. With <code>PollURL</code> it replaces itself with the fragment the URL returns every <code>Interval</code> until a fragment is <code>Done</code>.</p><h3>Example</h3>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = progress.Progress(progress.Props{Label: "Uploading", Value: 60, ShowPercent: true}).Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = progress.Progress(progress.Props{Label: "Loading", Indeterminate: true}).Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 44, "<h3>Usage</h3><pre><code>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var31 string
+			templ_7745c5c3_Var31, templ_7745c5c3_Err = templ.JoinStringErrs(`import "github.com/markopolo123/pico_templ/components/progress"
+
+// The handler at /jobs/1/progress renders the next fragment, with Done set once finished
+@progress.Progress(progress.Props{
+    ID:       "job-1",
+    Label:    "Import",
+    Value:    job.Percent,
+    PollURL:  "/jobs/1/progress",
+    Interval: 2 * time.Second,
+    Done:     job.Finished,
+})`)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/components.templ`, Line: 741, Col: 3}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var31))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 40, "</div><p>Want to contribute? Check out the <a href=\"https://github.com/markopolo123/pico_templ\" target=\"_blank\" rel=\"noopener noreferrer\">GitHub repository</a> to get started.</p></section>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 45, "</code></pre></section>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
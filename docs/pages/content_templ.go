@@ -9,6 +9,7 @@ import "github.com/a-h/templ"
 import templruntime "github.com/a-h/templ/runtime"
 
 import (
+	"github.com/markopolo123/pico_templ/attrs"
 	"github.com/markopolo123/pico_templ/content/link"
 	"github.com/markopolo123/pico_templ/content/loading"
 	"github.com/markopolo123/pico_templ/content/table"
@@ -231,7 +232,7 @@ func Content() templ.Component {
 }
 // ... H3, H4, H5, H6`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 117, Col: 22}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 118, Col: 22}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var10))
 			if templ_7745c5c3_Err != nil {
@@ -252,7 +253,7 @@ func Content() templ.Component {
 @typography.Code(typography.TextProps{}) { inline code }
 @typography.Abbr("Full Title", typography.TextProps{}) { ABBR }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 141, Col: 64}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 142, Col: 64}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var11))
 			if templ_7745c5c3_Err != nil {
@@ -296,7 +297,7 @@ func Content() templ.Component {
     </footer>
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 162, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 163, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var13))
 			if templ_7745c5c3_Err != nil {
@@ -335,7 +336,7 @@ func Content() templ.Component {
     fmt.Println("Hello, pico_templ!")
 }`)
 					if templ_7745c5c3_Err != nil {
-						return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 172, Col: 2}
+						return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 173, Col: 2}
 					}
 					_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var16))
 					if templ_7745c5c3_Err != nil {
@@ -364,7 +365,7 @@ func Content() templ.Component {
     }
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 183, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 184, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var17))
 			if templ_7745c5c3_Err != nil {
@@ -826,7 +827,7 @@ func Content() templ.Component {
     }
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 306, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 307, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var37))
 			if templ_7745c5c3_Err != nil {
@@ -1715,7 +1716,7 @@ func Content() templ.Component {
     // Table content...
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 419, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 420, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var75))
 			if templ_7745c5c3_Err != nil {
@@ -1804,7 +1805,7 @@ func Content() templ.Component {
     Secondary Link
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 508, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 509, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var79))
 			if templ_7745c5c3_Err != nil {
@@ -1845,7 +1846,7 @@ func Content() templ.Component {
     View on GitHub (opens in new tab)
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 524, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 525, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var81))
 			if templ_7745c5c3_Err != nil {
@@ -1930,7 +1931,7 @@ func Content() templ.Component {
     Primary Button Link
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 548, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 549, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var85))
 			if templ_7745c5c3_Err != nil {
@@ -1959,10 +1960,8 @@ func Content() templ.Component {
 				return nil
 			})
 			templ_7745c5c3_Err = link.Link(link.Props{
-				Href:     "#",
-				HxGet:    "/api/content",
-				HxTarget: "#content",
-				HxSwap:   "innerHTML",
+				Href: "#",
+				Htmx: attrs.HtmxAttrs{Get: "/api/content", Target: "#content", Swap: "innerHTML"},
 			}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var86), templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
@@ -1973,21 +1972,19 @@ func Content() templ.Component {
 			}
 			var templ_7745c5c3_Var87 string
 			templ_7745c5c3_Var87, templ_7745c5c3_Err = templ.JoinStringErrs(`@link.Link(link.Props{
-    Href:     "#",
-    HxGet:    "/api/content",
-    HxTarget: "#content",
-    HxSwap:   "innerHTML",
+    Href: "#",
+    Htmx: attrs.HtmxAttrs{Get: "/api/content", Target: "#content", Swap: "innerHTML"},
 }) {
     Load Content via HTMX
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 574, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 571, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var87))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 110, "</code></pre></div><h3>Link Props</h3><table class=\"props-table\"><thead><tr><th>Prop</th><th>Type</th><th>Description</th></tr></thead> <tbody><tr><td><code>Href</code></td><td><code>string</code></td><td>URL to link to</td></tr><tr><td><code>Target</code></td><td><code>string</code></td><td>blank, self, parent, top</td></tr><tr><td><code>Secondary</code></td><td><code>bool</code></td><td>Use secondary color styling</td></tr><tr><td><code>Contrast</code></td><td><code>bool</code></td><td>Use contrast color styling</td></tr><tr><td><code>HxGet</code></td><td><code>string</code></td><td>HTMX hx-get attribute</td></tr><tr><td><code>HxTarget</code></td><td><code>string</code></td><td>HTMX hx-target attribute</td></tr><tr><td><code>HxSwap</code></td><td><code>string</code></td><td>HTMX hx-swap attribute</td></tr></tbody></table></section><!-- Loading Section --> <section class=\"component-section\"><h2>Loading</h2><p>Loading components provide visual indicators for asynchronous operations. Pico CSS uses aria-busy true to display loading spinners.</p><h3>Spinner</h3><p>Basic loading spinner using a div element.</p><div class=\"example-box\"><div class=\"loading-demo\">")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 110, "</code></pre></div><h3>Link Props</h3><table class=\"props-table\"><thead><tr><th>Prop</th><th>Type</th><th>Description</th></tr></thead> <tbody><tr><td><code>Href</code></td><td><code>string</code></td><td>URL to link to</td></tr><tr><td><code>Target</code></td><td><code>string</code></td><td>blank, self, parent, top</td></tr><tr><td><code>Secondary</code></td><td><code>bool</code></td><td>Use secondary color styling</td></tr><tr><td><code>Contrast</code></td><td><code>bool</code></td><td>Use contrast color styling</td></tr><tr><td><code>Htmx</code></td><td><code>attrs.HtmxAttrs</code></td><td>HTMX attributes (the flat HxGet, HxTarget, … fields are deprecated aliases)</td></tr></tbody></table></section><!-- Loading Section --> <section class=\"component-section\"><h2>Loading</h2><p>Loading components provide visual indicators for asynchronous operations. Pico CSS uses aria-busy true to display loading spinners.</p><h3>Spinner</h3><p>Basic loading spinner using a div element.</p><div class=\"example-box\"><div class=\"loading-demo\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -2002,7 +1999,7 @@ func Content() templ.Component {
 			var templ_7745c5c3_Var88 string
 			templ_7745c5c3_Var88, templ_7745c5c3_Err = templ.JoinStringErrs(`@loading.Spinner(loading.Props{})`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 641, Col: 52}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 628, Col: 52}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var88))
 			if templ_7745c5c3_Err != nil {
@@ -2026,7 +2023,7 @@ func Content() templ.Component {
     @loading.SpinnerInline(loading.Props{})
 </p>`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 657, Col: 5}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 644, Col: 5}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var89))
 			if templ_7745c5c3_Err != nil {
@@ -2059,7 +2056,7 @@ func Content() templ.Component {
 // Loading state
 @loading.LoadingButton(loading.ButtonProps{Text: "Processing...", Loading: true})`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 677, Col: 82}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 664, Col: 82}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var90))
 			if templ_7745c5c3_Err != nil {
@@ -2100,7 +2097,7 @@ func Content() templ.Component {
     <p>Content is loading...</p>
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 693, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 680, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var92))
 			if templ_7745c5c3_Err != nil {
@@ -2121,7 +2118,7 @@ func Content() templ.Component {
 			var templ_7745c5c3_Var93 string
 			templ_7745c5c3_Var93, templ_7745c5c3_Err = templ.JoinStringErrs(`@loading.LoadingPlaceholder(loading.Props{})`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 703, Col: 63}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/content.templ`, Line: 690, Col: 63}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var93))
 			if templ_7745c5c3_Err != nil {
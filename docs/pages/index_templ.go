@@ -105,8 +105,7 @@ templ Page() {
                     @button.Button(button.Props{
                         Label:   "Get Started",
                         Variant: button.Primary,
-                        HxGet:   "/getting-started",
-                        HxSwap:  "innerHTML",
+                        Htmx:    attrs.HtmxAttrs{Get: "/getting-started", Swap: "innerHTML"},
                     })
                 }
             </main>
@@ -114,7 +113,7 @@ templ Page() {
     </html>
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/index.templ`, Line: 202, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/index.templ`, Line: 201, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var4))
 			if templ_7745c5c3_Err != nil {
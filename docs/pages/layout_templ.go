@@ -9,8 +9,14 @@ import "github.com/a-h/templ"
 import templruntime "github.com/a-h/templ/runtime"
 
 import (
+	"github.com/markopolo123/pico_templ/attrs"
+	"github.com/markopolo123/pico_templ/components/button"
 	"github.com/markopolo123/pico_templ/docs/templates"
+	"github.com/markopolo123/pico_templ/forms/input"
 	"github.com/markopolo123/pico_templ/layout/container"
+	"github.com/markopolo123/pico_templ/layout/grid"
+	"github.com/markopolo123/pico_templ/layout/group"
+	"github.com/markopolo123/pico_templ/layout/tooltip"
 )
 
 func Layout() templ.Component {
@@ -81,7 +87,7 @@ func Layout() templ.Component {
     <p>Your content here</p>
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 49, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 55, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var4))
 			if templ_7745c5c3_Err != nil {
@@ -122,7 +128,7 @@ func Layout() templ.Component {
     <p>Full-width content here</p>
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 65, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 71, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
 			if templ_7745c5c3_Err != nil {
@@ -139,106 +145,344 @@ func Layout() templ.Component {
     <p>Content with custom styling</p>
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 76, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 82, Col: 2}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var7))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "</code></pre><h3>Props Reference</h3><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Fluid</code></td><td>bool</td><td>false</td><td>Use .container-fluid for full-width layout</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes to apply</td></tr><tr><td><code>Attrs</code></td><td>templ.Attributes</td><td>nil</td><td>Arbitrary additional HTML attributes</td></tr></tbody></table></figure></section><hr><!-- Grid Section (Coming Soon) --> <section id=\"grid\"><h2>Grid</h2><mark>Coming Soon</mark><p>The Grid component will provide a flexible CSS grid system for creating responsive multi-column layouts. It will support configurable columns, gaps, and alignment options.</p><h3>Planned Features</h3><ul><li>Configurable number of columns</li><li>Responsive breakpoints</li><li>Gap control (row and column)</li><li>Alignment options (items and content)</li></ul><h3>Preview (using native CSS grid)</h3><div style=\"display: grid; grid-template-columns: repeat(3, 1fr); gap: 1rem; margin-bottom: 1rem;\"><div style=\"background: var(--pico-primary-background); color: var(--pico-primary-inverse); padding: 1rem; text-align: center; border-radius: var(--pico-border-radius);\">Column 1</div><div style=\"background: var(--pico-primary-background); color: var(--pico-primary-inverse); padding: 1rem; text-align: center; border-radius: var(--pico-border-radius);\">Column 2</div><div style=\"background: var(--pico-primary-background); color: var(--pico-primary-inverse); padding: 1rem; text-align: center; border-radius: var(--pico-border-radius);\">Column 3</div></div><pre><code>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "</code></pre><h3>Props Reference</h3><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Fluid</code></td><td>bool</td><td>false</td><td>Use .container-fluid for full-width layout</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes to apply</td></tr><tr><td><code>Attrs</code></td><td>templ.Attributes</td><td>nil</td><td>Arbitrary additional HTML attributes</td></tr></tbody></table></figure></section><hr><!-- Grid Section --> <section id=\"grid\"><h2>Grid</h2><p>The Grid component renders the Pico CSS <code>grid</code> class, whose children become equal-width columns that stack on narrow viewports. <code>Columns</code> fixes the column count, <code>Gap</code> sets the spacing and <code>CollapseBelow</code> chooses where the columns stack. Wrap a child in <code>Column</code> to span several columns.</p><h3>Equal Columns</h3>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			var templ_7745c5c3_Var8 string
-			templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinStringErrs(`// Planned API (subject to change)
-@grid.Grid(grid.Props{Columns: 3, Gap: "1rem"}) {
+			templ_7745c5c3_Var8 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+				if !templ_7745c5c3_IsBuffer {
+					defer func() {
+						templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+						if templ_7745c5c3_Err == nil {
+							templ_7745c5c3_Err = templ_7745c5c3_BufErr
+						}
+					}()
+				}
+				ctx = templ.InitializeContext(ctx)
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "<article>Column 1</article><article>Column 2</article><article>Column 3</article>")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				return nil
+			})
+			templ_7745c5c3_Err = grid.Grid(grid.Props{}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var8), templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "<h3>Fixed Columns with Spans</h3>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Var9 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+				if !templ_7745c5c3_IsBuffer {
+					defer func() {
+						templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+						if templ_7745c5c3_Err == nil {
+							templ_7745c5c3_Err = templ_7745c5c3_BufErr
+						}
+					}()
+				}
+				ctx = templ.InitializeContext(ctx)
+				templ_7745c5c3_Var10 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+					if !templ_7745c5c3_IsBuffer {
+						defer func() {
+							templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+							if templ_7745c5c3_Err == nil {
+								templ_7745c5c3_Err = templ_7745c5c3_BufErr
+							}
+						}()
+					}
+					ctx = templ.InitializeContext(ctx)
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "<article>Main content (spans 3)</article>")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					return nil
+				})
+				templ_7745c5c3_Err = grid.Column(grid.ColumnProps{Span: 3}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var10), templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, " <article>Sidebar</article>")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				return nil
+			})
+			templ_7745c5c3_Err = grid.Grid(grid.Props{Columns: 4, Gap: grid.GapSmall}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var9), templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "<h3>Usage</h3><pre><code>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var11 string
+			templ_7745c5c3_Var11, templ_7745c5c3_Err = templ.JoinStringErrs(`import "github.com/markopolo123/pico_templ/layout/grid"
+
+// Equal auto-fit columns
+@grid.Grid(grid.Props{}) {
     <div>Column 1</div>
     <div>Column 2</div>
     <div>Column 3</div>
+}
+
+// Four columns with a small gap that never stack; the first cell spans three
+@grid.Grid(grid.Props{Columns: 4, Gap: grid.GapSmall, CollapseBelow: grid.Never}) {
+    @grid.Column(grid.ColumnProps{Span: 3}) {
+        <div>Main</div>
+    }
+    <div>Sidebar</div>
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 149, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 160, Col: 2}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var8))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var11))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "</code></pre></section><hr><!-- Group Section (Coming Soon) --> <section id=\"group\"><h2>Group</h2><mark>Coming Soon</mark><p>The Group component will provide a flexbox-based horizontal or vertical grouping of elements with consistent spacing. Ideal for button groups, navigation items, or any collection of related elements.</p><h3>Planned Features</h3><ul><li>Horizontal and vertical orientation</li><li>Configurable gap spacing</li><li>Alignment and justification options</li><li>Wrap behavior control</li></ul><h3>Preview (using native flexbox)</h3><div style=\"display: flex; gap: 0.5rem; margin-bottom: 1rem;\"><button class=\"secondary\">Action 1</button> <button class=\"secondary\">Action 2</button> <button class=\"secondary\">Action 3</button></div><pre><code>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "</code></pre><h3>Props Reference</h3><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Columns</code></td><td>int</td><td>0</td><td>Explicit column count (1-12); 0 uses equal auto-fit columns</td></tr><tr><td><code>Gap</code></td><td>grid.Gap</td><td>GapDefault</td><td>Cell spacing: GapNone, GapSmall or GapLarge</td></tr><tr><td><code>CollapseBelow</code></td><td>grid.Breakpoint</td><td>Medium</td><td>Viewport below which columns stack: Small, Medium, Large, XLarge, XXLarge or Never</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr><tr><td><code>Attrs</code></td><td>templ.Attributes</td><td>nil</td><td>Arbitrary additional HTML attributes</td></tr></tbody></table></figure></section><hr><!-- Group Section --> <section id=\"group\"><h2>Group</h2><p>The group package joins related controls with Pico CSS's <code>role=\"group\"</code>. <code>Group</code> wraps any children, and <code>SearchGroup</code>, <code>CopyGroup</code> and <code>ButtonGroup</code> build the common input and button combinations.</p><h3>Button Group</h3>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			var templ_7745c5c3_Var9 string
-			templ_7745c5c3_Var9, templ_7745c5c3_Err = templ.JoinStringErrs(`// Planned API (subject to change)
-@group.Group(group.Props{Gap: "0.5rem"}) {
-    <button>Action 1</button>
-    <button>Action 2</button>
-    <button>Action 3</button>
-}`)
+			templ_7745c5c3_Err = group.ButtonGroup([]button.Props{
+				{Text: "Day", Variant: button.Secondary},
+				{Text: "Week", Variant: button.Secondary},
+				{Text: "Month", Variant: button.Secondary},
+			}).Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "<h3>Search Group</h3>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = group.SearchGroup(group.SearchProps{
+				Input: input.Props{Name: "q", Placeholder: "Search the docs"},
+			}).Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, "<h3>Copy Group</h3>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = group.CopyGroup(group.CopyProps{
+				Input: input.Props{Name: "install", Value: "go get github.com/markopolo123/pico_templ"},
+			}).Render(ctx, templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 183, Col: 2}
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 17, "<h3>Usage</h3><pre><code>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var12 string
+			templ_7745c5c3_Var12, templ_7745c5c3_Err = templ.JoinStringErrs(`import "github.com/markopolo123/pico_templ/layout/group"
+
+// Any children; Fieldset renders a <fieldset> for form controls
+@group.Group(group.Props{Fieldset: true}) {
+    <input type="email" name="email" placeholder="Email"/>
+    <input type="submit" value="Subscribe"/>
+}
+
+// Search input joined to a submit button (Text defaults to "Search")
+@group.SearchGroup(group.SearchProps{Input: input.Props{Name: "q"}})
+
+// Read-only input with a button copying its value (Text defaults to "Copy")
+@group.CopyGroup(group.CopyProps{Input: input.Props{Name: "key", Value: apiKey}})
+
+// Joined buttons
+@group.ButtonGroup([]button.Props{{Text: "Day"}, {Text: "Week"}})`)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 250, Col: 66}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var9))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var12))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "</code></pre></section><hr><!-- Tooltip Section (Coming Soon) --> <section id=\"tooltip\"><h2>Tooltip</h2><mark>Coming Soon</mark><p>The Tooltip component will provide accessible tooltip functionality using Pico CSS's built-in tooltip support. Tooltips display additional information when users hover over or focus on an element.</p><h3>Planned Features</h3><ul><li>Multiple placement options (top, bottom, left, right)</li><li>Keyboard accessible</li><li>Custom tooltip content</li><li>Delay configuration</li></ul><h3>Preview (using native Pico tooltip)</h3><p>Hover over this <span data-tooltip=\"This is a tooltip!\" style=\"text-decoration: underline; cursor: help;\">underlined text</span> to see a tooltip.</p><pre><code>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 18, "</code></pre><h3>Props Reference</h3><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Fieldset</code></td><td>bool</td><td>false</td><td>Render a <code>&lt;fieldset&gt;</code> instead of a <code>&lt;div&gt;</code> (Group)</td></tr><tr><td><code>Input</code></td><td>input.Props</td><td>-</td><td>The joined input; Label and HelperText are ignored (SearchGroup, CopyGroup)</td></tr><tr><td><code>Button</code></td><td>button.Props</td><td>-</td><td>The joined button (SearchGroup, CopyGroup)</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr><tr><td><code>Attrs</code></td><td>templ.Attributes</td><td>nil</td><td>Arbitrary additional HTML attributes</td></tr></tbody></table></figure></section><hr><!-- Tooltip Section --> <section id=\"tooltip\"><h2>Tooltip</h2><p>The Tooltip component wraps its children in a span with Pico CSS's <code>data-tooltip</code>, shown on hover and focus. Buttons, links and typography elements take the same <code>Tooltip</code> and <code>TooltipPlacement</code> fields directly. An unsupported placement fails the render.</p><h3>Placements</h3><p>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			var templ_7745c5c3_Var10 string
-			templ_7745c5c3_Var10, templ_7745c5c3_Err = templ.JoinStringErrs(`// Planned API (subject to change)
+			templ_7745c5c3_Var13 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+				if !templ_7745c5c3_IsBuffer {
+					defer func() {
+						templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+						if templ_7745c5c3_Err == nil {
+							templ_7745c5c3_Err = templ_7745c5c3_BufErr
+						}
+					}()
+				}
+				ctx = templ.InitializeContext(ctx)
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 19, "Top (default)")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				return nil
+			})
+			templ_7745c5c3_Err = tooltip.Tooltip(tooltip.Props{Text: "Shown above", Attrs: templ.Attributes{"tabindex": "0"}}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var13), templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 20, "·")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Var14 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+				if !templ_7745c5c3_IsBuffer {
+					defer func() {
+						templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+						if templ_7745c5c3_Err == nil {
+							templ_7745c5c3_Err = templ_7745c5c3_BufErr
+						}
+					}()
+				}
+				ctx = templ.InitializeContext(ctx)
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 21, "Right")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				return nil
+			})
+			templ_7745c5c3_Err = tooltip.Tooltip(tooltip.Props{Text: "Shown to the right", Placement: attrs.PlacementRight, Attrs: templ.Attributes{"tabindex": "0"}}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var14), templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 22, "·")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Var15 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+				if !templ_7745c5c3_IsBuffer {
+					defer func() {
+						templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+						if templ_7745c5c3_Err == nil {
+							templ_7745c5c3_Err = templ_7745c5c3_BufErr
+						}
+					}()
+				}
+				ctx = templ.InitializeContext(ctx)
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 23, "Bottom")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				return nil
+			})
+			templ_7745c5c3_Err = tooltip.Tooltip(tooltip.Props{Text: "Shown below", Placement: attrs.PlacementBottom, Attrs: templ.Attributes{"tabindex": "0"}}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var15), templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 24, "·")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Var16 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+				if !templ_7745c5c3_IsBuffer {
+					defer func() {
+						templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+						if templ_7745c5c3_Err == nil {
+							templ_7745c5c3_Err = templ_7745c5c3_BufErr
+						}
+					}()
+				}
+				ctx = templ.InitializeContext(ctx)
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 25, "Left")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				return nil
+			})
+			templ_7745c5c3_Err = tooltip.Tooltip(tooltip.Props{Text: "Shown to the left", Placement: attrs.PlacementLeft, Attrs: templ.Attributes{"tabindex": "0"}}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var16), templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 26, "</p><h3>Usage</h3><pre><code>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var17 string
+			templ_7745c5c3_Var17, templ_7745c5c3_Err = templ.JoinStringErrs(`import (
+    "github.com/markopolo123/pico_templ/attrs"
+    "github.com/markopolo123/pico_templ/layout/tooltip"
+)
+
+// tabindex makes the wrapper reachable by keyboard so the tooltip shows on focus
 @tooltip.Tooltip(tooltip.Props{
-    Content: "This is a tooltip!",
-    Position: "top",
+    Text:      "Saved 2 minutes ago",
+    Placement: attrs.PlacementBottom,
+    Attrs:     templ.Attributes{"tabindex": "0"},
 }) {
-    <span>Hover me</span>
-}`)
+    <span>Status</span>
+}
+
+// Components with a built-in tooltip
+@button.Button(button.Props{Text: "Save", Tooltip: "Ctrl+S", TooltipPlacement: attrs.PlacementRight})`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 216, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 344, Col: 102}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var10))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var17))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "</code></pre></section><hr><!-- Usage Tips Section --> <section id=\"usage-tips\"><h2>Usage Tips</h2><h3>Import Statement</h3><pre><code>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 27, "</code></pre><h3>Props Reference</h3><figure><table><thead><tr><th>Prop</th><th>Type</th><th>Default</th><th>Description</th></tr></thead> <tbody><tr><td><code>Text</code></td><td>string</td><td>\"\"</td><td>Tooltip content; no tooltip is shown when empty</td></tr><tr><td><code>Placement</code></td><td>attrs.Placement</td><td>PlacementDefault</td><td>PlacementTop, PlacementRight, PlacementBottom or PlacementLeft</td></tr><tr><td><code>Class</code></td><td>string</td><td>\"\"</td><td>Additional CSS classes</td></tr><tr><td><code>Attrs</code></td><td>templ.Attributes</td><td>nil</td><td>Arbitrary additional HTML attributes</td></tr></tbody></table></figure></section><hr><!-- Usage Tips Section --> <section id=\"usage-tips\"><h2>Usage Tips</h2><h3>Import Statement</h3><pre><code>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			var templ_7745c5c3_Var11 string
-			templ_7745c5c3_Var11, templ_7745c5c3_Err = templ.JoinStringErrs(`import (
+			var templ_7745c5c3_Var18 string
+			templ_7745c5c3_Var18, templ_7745c5c3_Err = templ.JoinStringErrs(`import (
     "github.com/markopolo123/pico_templ/layout/container"
-    // Future imports:
-    // "github.com/markopolo123/pico_templ/layout/grid"
-    // "github.com/markopolo123/pico_templ/layout/group"
-    // "github.com/markopolo123/pico_templ/layout/tooltip"
+    "github.com/markopolo123/pico_templ/layout/grid"
+    "github.com/markopolo123/pico_templ/layout/group"
+    "github.com/markopolo123/pico_templ/layout/tooltip"
 )`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 233, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 399, Col: 2}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var11))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var18))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, "</code></pre><h3>Combining Layout Components</h3><p>Layout components can be nested and combined to create complex page structures. For example, use a Container to constrain width, then a Grid inside for multi-column content.</p><pre><code>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 28, "</code></pre><h3>Combining Layout Components</h3><p>Layout components can be nested and combined to create complex page structures. For example, use a Container to constrain width, then a Grid inside for multi-column content.</p><pre><code>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			var templ_7745c5c3_Var12 string
-			templ_7745c5c3_Var12, templ_7745c5c3_Err = templ.JoinStringErrs(`@container.Container(container.Props{}) {
+			var templ_7745c5c3_Var19 string
+			templ_7745c5c3_Var19, templ_7745c5c3_Err = templ.JoinStringErrs(`@container.Container(container.Props{}) {
     // Grid inside container
-    <div class="grid">
+    @grid.Grid(grid.Props{}) {
         <div>Sidebar</div>
         <div>Main Content</div>
-    </div>
+    }
 }`)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 250, Col: 2}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `docs/pages/layout.templ`, Line: 416, Col: 2}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var12))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var19))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "</code></pre></section>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 29, "</code></pre></section>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -0,0 +1,173 @@
+package document
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
+	"github.com/markopolo123/pico_templ/head"
+	"github.com/markopolo123/pico_templ/layout/grid"
+	"github.com/markopolo123/pico_templ/theme"
+)
+
+func render(t *testing.T, ctx context.Context, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(ctx, &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+func TestHtml_Defaults(t *testing.T) {
+	html := render(t, context.Background(), Html(HtmlProps{}))
+
+	if html != `<!doctype html><html lang="en"></html>` {
+		t.Errorf("expected bare document, got: %s", html)
+	}
+}
+
+func TestHtml_SchemeFromContext(t *testing.T) {
+	ctx := theme.WithScheme(context.Background(), theme.Dark)
+	html := render(t, ctx, Html(HtmlProps{Lang: "de"}))
+
+	if !strings.Contains(html, `<html lang="de" data-theme="dark">`) {
+		t.Errorf("expected data-theme from context, got: %s", html)
+	}
+}
+
+func TestHtml_ExplicitSchemeOverridesContext(t *testing.T) {
+	ctx := theme.WithScheme(context.Background(), theme.Dark)
+	html := render(t, ctx, Html(HtmlProps{Theme: theme.Light}))
+
+	if !strings.Contains(html, `data-theme="light"`) {
+		t.Errorf("expected explicit scheme, got: %s", html)
+	}
+}
+
+func TestHtml_Dir(t *testing.T) {
+	html := render(t, context.Background(), Html(HtmlProps{Lang: "ar", Dir: "rtl"}))
+
+	if !strings.Contains(html, `<html lang="ar" dir="rtl">`) {
+		t.Errorf("expected dir attribute, got: %s", html)
+	}
+}
+
+func TestDocument_RendersPageShell(t *testing.T) {
+	props := DefaultProps()
+	props.Head.Title = "Home"
+	ctx := templ.WithChildren(context.Background(), templ.Raw("<main>Content</main>"))
+	html := render(t, ctx, Document(props))
+
+	expectations := []string{
+		`<!doctype html><html lang="en"><head>`,
+		`<title>Home</title>`,
+		`<style>`,
+		`<body><main>Content</main></body></html>`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s in document", exp)
+		}
+	}
+}
+
+func TestDocument_RootAndBodyAttributes(t *testing.T) {
+	html := render(t, context.Background(), Document(Props{
+		Lang:      "fr",
+		Dir:       "ltr",
+		Theme:     theme.Dark,
+		Head:      head.Props{Title: "Accueil"},
+		BodyClass: "container",
+		BodyAttrs: templ.Attributes{"hx-boost": "true", "hx-ext": "preload"},
+		Attrs:     templ.Attributes{"class": "no-js"},
+	}))
+
+	expectations := []string{
+		`<html lang="fr" dir="ltr" data-theme="dark" class="no-js">`,
+		`<body class="container" hx-boost="true" hx-ext="preload">`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+	if strings.Contains(html, "<style>") || strings.Contains(html, "<script>") {
+		t.Errorf("expected no assets when none are included, got: %s", html)
+	}
+}
+
+func TestDocument_BodyExtensions(t *testing.T) {
+	attrs := templ.Attributes{"hx-ext": "json-enc"}
+	props := Props{
+		Head:      head.Props{Title: "Test", Extensions: []string{"sse", "preload"}},
+		BodyAttrs: attrs,
+	}
+
+	if got := props.bodyAttrs()["hx-ext"]; got != "json-enc,sse,preload" {
+		t.Errorf("expected head extensions appended to hx-ext, got %v", got)
+	}
+	if attrs["hx-ext"] != "json-enc" {
+		t.Error("expected caller attrs map not to be modified")
+	}
+
+	if _, err := head.GetExtension("sse"); err != nil {
+		var buf bytes.Buffer
+		if err := Document(props).Render(context.Background(), &buf); err == nil || !strings.Contains(err.Error(), "not vendored") {
+			t.Errorf("expected not vendored error, got %v", err)
+		}
+		return
+	}
+	html := render(t, context.Background(), Document(props))
+	if !strings.Contains(html, `<body hx-ext="json-enc,sse,preload">`) {
+		t.Errorf("expected hx-ext on body, got: %s", html)
+	}
+}
+
+func TestDocument_SchemeFromContext(t *testing.T) {
+	ctx := theme.WithScheme(context.Background(), theme.Light)
+	html := render(t, ctx, Document(Props{}))
+
+	if !strings.Contains(html, `data-theme="light"`) {
+		t.Errorf("expected data-theme from context, got: %s", html)
+	}
+}
+
+func TestDocument_IncludesAssetsRequiredByComponents(t *testing.T) {
+	props := Props{Head: head.Props{Title: "Test", IncludeHTMX: true, AssetMode: head.AssetModeServed}}
+	ctx := templ.WithChildren(context.Background(), templ.Join(
+		assets.Require(assets.Hyperscript),
+		assets.Require(assets.HTMX, assets.Hyperscript),
+	))
+	html := render(t, ctx, Document(props))
+
+	hyperscript := `<script src="` + head.AssetURL("", "_hyperscript.min.js") + `"`
+	if count := strings.Count(html, hyperscript); count != 1 {
+		t.Errorf("expected _hyperscript once, got %d: %s", count, html)
+	}
+	if !strings.HasSuffix(html, "</script></body></html>") {
+		t.Errorf("expected _hyperscript at the end of the body, got: %s", html)
+	}
+	if count := strings.Count(html, "htmx.min"); count != 1 {
+		t.Errorf("expected HTMX from Head only, got %d: %s", count, html)
+	}
+}
+
+func TestDocument_DefersComponentStyles(t *testing.T) {
+	ctx := templ.WithChildren(context.Background(), templ.Join(
+		grid.Grid(grid.Props{Columns: 2}),
+		grid.Grid(grid.Props{Columns: 3}),
+	))
+	html := render(t, ctx, Document(Props{Head: head.Props{Title: "Test"}}))
+
+	if count := strings.Count(html, "<style>.grid[data-gap=none]"); count != 1 {
+		t.Errorf("expected the grid stylesheet once, got %d: %s", count, html)
+	}
+	if !strings.HasSuffix(html, "</style></body></html>") {
+		t.Errorf("expected the grid stylesheet at the end of the body, got: %s", html)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
 )
 
 func render(t *testing.T, component templ.Component) string {
@@ -149,3 +150,11 @@ func TestCheckboxAttrsSpread(t *testing.T) {
 		t.Errorf("expected custom attribute, got: %s", html)
 	}
 }
+
+func TestCheckboxHtmxAttributes(t *testing.T) {
+	html := render(t, Checkbox(Props{Name: "done", Htmx: attrs.HtmxAttrs{Patch: "/todo/1"}}))
+
+	if !strings.Contains(html, `hx-patch="/todo/1"`) {
+		t.Errorf("expected HTMX attributes, got: %s", html)
+	}
+}
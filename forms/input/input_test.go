@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
 )
 
 func render(t *testing.T, c templ.Component) string {
@@ -209,3 +210,19 @@ func TestCustomAttrs(t *testing.T) {
 		t.Error("expected maxlength attribute")
 	}
 }
+
+func TestInputHtmxAttributes(t *testing.T) {
+	html := render(t, Input(Props{Name: "q", Htmx: attrs.HtmxAttrs{Get: "/search", Trigger: "keyup changed delay:300ms"}}))
+
+	if !strings.Contains(html, `hx-get="/search" hx-trigger="keyup changed delay:300ms"`) {
+		t.Errorf("expected HTMX attributes, got: %s", html)
+	}
+}
+
+func TestInputInvalidHtmxFailsRender(t *testing.T) {
+	var buf bytes.Buffer
+	err := Input(Props{Name: "q", Htmx: attrs.HtmxAttrs{Boost: "yes"}}).Render(context.Background(), &buf)
+	if err == nil || !strings.Contains(err.Error(), "hx-boost") {
+		t.Errorf("expected hx-boost error, got %v", err)
+	}
+}
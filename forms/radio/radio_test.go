@@ -5,6 +5,8 @@ import (
 	"context"
 	"strings"
 	"testing"
+
+	"github.com/markopolo123/pico_templ/attrs"
 )
 
 func TestRadioRendersInputTypeRadio(t *testing.T) {
@@ -202,3 +204,17 @@ func TestRadioIDAttribute(t *testing.T) {
 		t.Errorf("expected id='my-radio-id', got: %s", html)
 	}
 }
+
+func TestRadioGroupPassesHtmxAttributes(t *testing.T) {
+	var buf bytes.Buffer
+	err := RadioGroup(GroupProps{Name: "size", Options: []Props{
+		{Value: "s", Label: "Small", Htmx: attrs.HtmxAttrs{Get: "/price?size=s", Target: "#price"}},
+	}}).Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), `hx-get="/price?size=s" hx-target="#price"`) {
+		t.Errorf("expected HTMX attributes, got: %s", buf.String())
+	}
+}
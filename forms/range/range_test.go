@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
 )
 
 func renderToString(t *testing.T, component templ.Component) string {
@@ -141,3 +142,11 @@ func TestRangeFloatValues(t *testing.T) {
 		t.Errorf("expected value=\"5.5\", got: %s", html)
 	}
 }
+
+func TestRangeHtmxAttributes(t *testing.T) {
+	html := renderToString(t, Range(Props{Name: "volume", Htmx: attrs.HtmxAttrs{Put: "/volume", Trigger: "change"}}))
+
+	if !strings.Contains(html, `hx-put="/volume" hx-trigger="change"`) {
+		t.Errorf("expected HTMX attributes, got: %s", html)
+	}
+}
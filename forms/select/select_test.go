@@ -5,6 +5,8 @@ import (
 	"context"
 	"strings"
 	"testing"
+
+	"github.com/markopolo123/pico_templ/attrs"
 )
 
 func render(t *testing.T, props Props) string {
@@ -228,3 +230,11 @@ func TestDisabledOption(t *testing.T) {
 		t.Errorf("expected disabled option, got: %s", html)
 	}
 }
+
+func TestSelectHtmxAttributes(t *testing.T) {
+	html := render(t, Props{Name: "country", Htmx: attrs.HtmxAttrs{Get: "/regions", Target: "#region"}})
+
+	if !strings.Contains(html, `<select name="country" hx-get="/regions" hx-target="#region">`) {
+		t.Errorf("expected HTMX attributes, got: %s", html)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
 )
 
 func render(t *testing.T, component templ.Component) string {
@@ -137,3 +138,11 @@ func TestSwitchCustomAttrs(t *testing.T) {
 		t.Error("expected aria-label attribute")
 	}
 }
+
+func TestSwitchHtmxAttributes(t *testing.T) {
+	html := render(t, Switch(Props{Name: "notify", Htmx: attrs.HtmxAttrs{Post: "/settings", Swap: "none"}}))
+
+	if !strings.Contains(html, `hx-post="/settings" hx-swap="none"`) {
+		t.Errorf("expected HTMX attributes, got: %s", html)
+	}
+}
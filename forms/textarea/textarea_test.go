@@ -5,6 +5,8 @@ import (
 	"context"
 	"strings"
 	"testing"
+
+	"github.com/markopolo123/pico_templ/attrs"
 )
 
 func render(t *testing.T, props Props) string {
@@ -212,3 +214,19 @@ func TestCustomClass(t *testing.T) {
 		t.Error("expected custom class")
 	}
 }
+
+func TestTextareaHtmxAttributes(t *testing.T) {
+	html := render(t, Props{Name: "notes", Htmx: attrs.HtmxAttrs{Post: "/notes", Trigger: "keyup delay:1s"}})
+
+	if !strings.Contains(html, `hx-post="/notes" hx-trigger="keyup delay:1s"`) {
+		t.Errorf("expected HTMX attributes, got: %s", html)
+	}
+}
+
+func TestTextareaPassesRawTriggerThrough(t *testing.T) {
+	html := render(t, Props{Name: "notes", Htmx: attrs.HtmxAttrs{Post: "/notes", Trigger: "keyup delay:1s throttle:1s"}})
+
+	if !strings.Contains(html, `hx-trigger="keyup delay:1s throttle:1s"`) {
+		t.Errorf("expected raw trigger, got: %s", html)
+	}
+}
@@ -0,0 +1,115 @@
+package head
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func renderHeadWithNonce(t *testing.T, props Props, nonce string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := Head(props).Render(WithNonce(context.Background(), nonce), &buf)
+	if err != nil {
+		t.Fatalf("failed to render Head: %v", err)
+	}
+	return buf.String()
+}
+
+func TestNewNonceIsRandom(t *testing.T) {
+	a, err := NewNonce()
+	if err != nil {
+		t.Fatalf("failed to generate nonce: %v", err)
+	}
+	b, _ := NewNonce()
+	if a == "" || a == b {
+		t.Errorf("expected distinct non-empty nonces, got %q and %q", a, b)
+	}
+}
+
+func TestCSPMiddleware(t *testing.T) {
+	var nonce string
+	handler := CSP(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nonce = Nonce(r.Context())
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if nonce == "" {
+		t.Fatal("expected nonce in request context")
+	}
+	if got := rec.Header().Get("Content-Security-Policy"); got != DefaultCSP(nonce) {
+		t.Errorf("expected default policy with request nonce, got %s", got)
+	}
+}
+
+func TestCSPMiddlewareCustomPolicy(t *testing.T) {
+	handler := CSP(func(nonce string) string {
+		return "script-src 'nonce-" + nonce + "'"
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if got := rec.Header().Get("Content-Security-Policy"); !strings.HasPrefix(got, "script-src 'nonce-") {
+		t.Errorf("expected custom policy, got %s", got)
+	}
+}
+
+func TestHeadInlineAssetsCarryNonce(t *testing.T) {
+	props := DefaultProps()
+	props.Title = "Test"
+
+	html := renderHeadWithNonce(t, props, "abc123")
+
+	if !strings.Contains(html, `<style nonce="abc123">`) {
+		t.Error("expected nonce on Pico CSS style tag")
+	}
+	if count := strings.Count(html, `<script nonce="abc123">`); count != 2 {
+		t.Errorf("expected nonce on both inline scripts, got %d", count)
+	}
+	if !strings.Contains(html, `<meta name="htmx-config" content="{&#34;inlineScriptNonce&#34;:&#34;abc123&#34;,&#34;inlineStyleNonce&#34;:&#34;abc123&#34;}">`) {
+		t.Error("expected htmx-config meta with nonces")
+	}
+}
+
+func TestHeadServedAssetsCarryNonce(t *testing.T) {
+	props := DefaultProps()
+	props.Title = "Test"
+	props.AssetMode = AssetModeServed
+
+	html := renderHeadWithNonce(t, props, "abc123")
+
+	if !strings.Contains(html, `href="`+AssetURL("", "pico.min.css")+`" integrity="`+Integrity("pico.min.css")+`" crossorigin="anonymous" nonce="abc123">`) {
+		t.Errorf("expected nonce on stylesheet link, got: %s", html)
+	}
+	if !strings.Contains(html, `src="`+AssetURL("", "htmx.min.js")+`" integrity="`+Integrity("htmx.min.js")+`" crossorigin="anonymous" nonce="abc123"></script>`) {
+		t.Errorf("expected nonce on HTMX script, got: %s", html)
+	}
+}
+
+func TestHeadWithoutNonce(t *testing.T) {
+	props := DefaultProps()
+	props.Title = "Test"
+
+	html := renderHead(t, props)
+
+	if !strings.Contains(html, "<style>") || !strings.Contains(html, "<script>") {
+		t.Error("expected inline assets without nonce attributes")
+	}
+	if strings.Contains(html, `<meta name="htmx-config"`) {
+		t.Error("expected no htmx-config without a nonce")
+	}
+}
+
+func TestHTMXConfigOmittedWithoutHTMX(t *testing.T) {
+	html := renderHeadWithNonce(t, Props{Title: "Test", IncludePico: true}, "abc123")
+
+	if strings.Contains(html, `<meta name="htmx-config"`) {
+		t.Error("expected no htmx-config when HTMX is not included")
+	}
+}
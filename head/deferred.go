@@ -0,0 +1,37 @@
+package head
+
+import (
+	"context"
+	"io"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
+)
+
+// Deferred includes the scripts, HTMX extensions and styles that components required during
+// the render and that Head did not already include. Render it at the end of
+// body, within a context carrying an assets.Registry; document.Document does
+// both. It renders nothing without a registry.
+func Deferred(props Props) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		registry := assets.FromContext(ctx)
+		pending := registry.Pending()
+		for _, asset := range pending.Assets {
+			if err := props.script(asset).Render(ctx, w); err != nil {
+				return err
+			}
+		}
+		for _, name := range pending.Extensions {
+			if err := props.extension(name).Render(ctx, w); err != nil {
+				return err
+			}
+		}
+		for _, style := range pending.Styles {
+			registry.Include(style.ID)
+			if err := rawStyle(style.CSS).Render(ctx, w); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
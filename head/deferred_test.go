@@ -0,0 +1,72 @@
+package head
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
+)
+
+func renderWithRegistry(t *testing.T, ctx context.Context, components ...templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	for _, component := range components {
+		if err := component.Render(ctx, &buf); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+	}
+	return buf.String()
+}
+
+func TestDeferredIncludesRequiredAssets(t *testing.T) {
+	ctx := assets.WithRegistry(context.Background())
+	props := Props{Title: "Test", AssetMode: AssetModeServed}
+
+	html := renderWithRegistry(t, ctx,
+		Head(props),
+		assets.Require(assets.Hyperscript),
+		assets.RequireCSS("chart", ".chart{color:red}"),
+		Deferred(props),
+	)
+
+	if !strings.Contains(html, `<script src="`+AssetURL("", "_hyperscript.min.js")+`"`) {
+		t.Errorf("expected deferred _hyperscript, got: %s", html)
+	}
+	if !strings.Contains(html, `<style>.chart{color:red}</style>`) {
+		t.Errorf("expected deferred style, got: %s", html)
+	}
+	if strings.Contains(html, "htmx.min") {
+		t.Errorf("expected HTMX to be omitted when not required, got: %s", html)
+	}
+}
+
+func TestDeferredSkipsAssetsIncludedByHead(t *testing.T) {
+	ctx := assets.WithRegistry(context.Background())
+	props := Props{Title: "Test", AssetMode: AssetModeServed, IncludeHTMX: true}
+
+	html := renderWithRegistry(t, ctx,
+		Head(props),
+		assets.Require(assets.HTMX),
+		assets.RequireCSS("chart", ".chart{}"),
+		Deferred(props),
+		Deferred(props),
+	)
+
+	if count := strings.Count(html, "htmx.min"); count != 1 {
+		t.Errorf("expected HTMX once, got %d: %s", count, html)
+	}
+	if count := strings.Count(html, ".chart{}"); count != 1 {
+		t.Errorf("expected style once, got %d: %s", count, html)
+	}
+}
+
+func TestDeferredWithoutRegistryRendersNothing(t *testing.T) {
+	html := renderWithRegistry(t, context.Background(), assets.Require(assets.HTMX), Deferred(DefaultProps()))
+
+	if html != "" {
+		t.Errorf("expected no output, got: %s", html)
+	}
+}
@@ -0,0 +1,58 @@
+package head
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
+)
+
+// Extensions returns the names of the official HTMX extensions head vendors,
+// as used in hx-ext, in alphabetical order.
+func Extensions() []string {
+	names := make([]string, 0, len(HTMXExtensionVersions))
+	for name := range HTMXExtensionVersions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ExtensionFile returns the embedded file name of the named HTMX extension,
+// e.g. "htmx-ext-sse.js".
+func ExtensionFile(name string) string {
+	return "htmx-ext-" + name + ".js"
+}
+
+// GetExtension returns the embedded JavaScript of the named HTMX extension,
+// or an error if the extension is unknown or has not been vendored.
+func GetExtension(name string) ([]byte, error) {
+	if _, ok := HTMXExtensionVersions[name]; !ok {
+		return nil, fmt.Errorf("head: unknown HTMX extension %q", name)
+	}
+	asset, ok := servedAssets[ExtensionFile(name)]
+	if !ok {
+		return nil, fmt.Errorf("head: HTMX extension %s is not vendored, %s", name, vendorSteps)
+	}
+	return bytes.Clone(asset.data), nil
+}
+
+// extension includes the named HTMX extension, and HTMX before it, unless they
+// have already been included in this render. It fails to render if the
+// extension is unknown or has not been vendored.
+func (p Props) extension(name string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if _, err := GetExtension(name); err != nil {
+			return err
+		}
+		if err := p.script(assets.HTMX).Render(ctx, w); err != nil {
+			return err
+		}
+		assets.FromContext(ctx).Include(name)
+		return p.script(assets.Asset(ExtensionFile(name))).Render(ctx, w)
+	})
+}
@@ -0,0 +1,141 @@
+package head
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/markopolo123/pico_templ/assets"
+)
+
+func TestExtensions(t *testing.T) {
+	got := strings.Join(Extensions(), ",")
+	if got != "preload,response-targets,sse,ws" {
+		t.Errorf("expected sorted official extensions, got %s", got)
+	}
+	for _, name := range Extensions() {
+		if AssetURLs[ExtensionFile(name)] == "" {
+			t.Errorf("expected download URL for %s", name)
+		}
+	}
+}
+
+func TestGetExtensionRejectsUnknownNames(t *testing.T) {
+	if _, err := GetExtension("nope"); err == nil || !strings.Contains(err.Error(), "unknown HTMX extension") {
+		t.Errorf("expected unknown extension error, got %v", err)
+	}
+}
+
+// vendorExtensions registers placeholder sources for the named extensions
+// that are not vendored, so the rendering paths are tested either way.
+func vendorExtensions(t *testing.T, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		file := ExtensionFile(name)
+		if _, ok := servedAssets[file]; ok {
+			continue
+		}
+		asset := newServedAsset(file, []byte("htmx.defineExtension('"+name+"', {})"))
+		servedAssets[asset.name] = asset
+		hashedAssets[asset.hashedName] = asset
+		t.Cleanup(func() {
+			delete(servedAssets, asset.name)
+			delete(hashedAssets, asset.hashedName)
+		})
+	}
+}
+
+func TestGetExtension(t *testing.T) {
+	for _, name := range Extensions() {
+		data, err := GetExtension(name)
+		recorded, vendored := AssetHashes[ExtensionFile(name)]
+		if !vendored {
+			if err == nil || !strings.Contains(err.Error(), "not vendored") || !strings.Contains(err.Error(), "-accept-new") {
+				t.Errorf("%s: expected vendoring steps, got %v", name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		sum := sha256.Sum256(data)
+		if hash := hex.EncodeToString(sum[:]); hash != recorded {
+			t.Errorf("%s: hash mismatch: expected %s, got %s", name, recorded, hash)
+		}
+		if !bytes.Contains(data, []byte("defineExtension")) {
+			t.Errorf("%s: expected extension source", name)
+		}
+	}
+}
+
+func TestHeadExtensionNotVendored(t *testing.T) {
+	name := Extensions()[0]
+	if asset, ok := servedAssets[ExtensionFile(name)]; ok {
+		delete(servedAssets, asset.name)
+		t.Cleanup(func() { servedAssets[asset.name] = asset })
+	}
+	props := Props{Title: "Test", Extensions: []string{name}}
+
+	var buf bytes.Buffer
+	if err := Head(props).Render(context.Background(), &buf); err == nil || !strings.Contains(err.Error(), "not vendored") {
+		t.Errorf("expected not vendored error, got %v", err)
+	}
+}
+
+func TestHeadUnknownExtension(t *testing.T) {
+	props := Props{Title: "Test", Extensions: []string{"nope"}}
+
+	var buf bytes.Buffer
+	if err := Head(props).Render(context.Background(), &buf); err == nil || !strings.Contains(err.Error(), "unknown HTMX extension") {
+		t.Errorf("expected unknown extension error, got %v", err)
+	}
+}
+
+func TestHeadExtensions(t *testing.T) {
+	vendorExtensions(t, "sse", "preload")
+	props := Props{Title: "Test", AssetMode: AssetModeServed, Extensions: []string{"sse", "preload"}}
+
+	html := renderHead(t, props)
+
+	htmx := strings.Index(html, AssetURL("", "htmx.min.js"))
+	sse := strings.Index(html, `<script src="`+AssetURL("", ExtensionFile("sse"))+`" integrity="`+Integrity(ExtensionFile("sse"))+`"`)
+	preload := strings.Index(html, `<script src="`+AssetURL("", ExtensionFile("preload"))+`"`)
+	if htmx < 0 || sse < htmx || preload < sse {
+		t.Errorf("expected HTMX followed by the extensions in order, got: %s", html)
+	}
+}
+
+func TestDeferredIncludesRequiredExtensions(t *testing.T) {
+	vendorExtensions(t, "sse", "ws")
+	ctx := assets.WithRegistry(context.Background())
+	props := Props{Title: "Test", AssetMode: AssetModeServed, Extensions: []string{"sse"}}
+
+	html := renderWithRegistry(t, ctx,
+		Head(props),
+		assets.RequireExtension("sse", "ws"),
+		Deferred(props),
+	)
+
+	if count := strings.Count(html, "htmx-ext-sse"); count != 1 {
+		t.Errorf("expected sse once, got %d: %s", count, html)
+	}
+	if count := strings.Count(html, "htmx.min"); count != 1 {
+		t.Errorf("expected HTMX once, got %d: %s", count, html)
+	}
+	if !strings.Contains(html, AssetURL("", ExtensionFile("ws"))) {
+		t.Errorf("expected deferred ws extension, got: %s", html)
+	}
+}
+
+func TestDeferredUnknownExtension(t *testing.T) {
+	ctx := assets.WithRegistry(context.Background())
+
+	var buf bytes.Buffer
+	assets.FromContext(ctx).RequireExtension("nope")
+	if err := Deferred(Props{}).Render(ctx, &buf); err == nil {
+		t.Error("expected unknown extension error")
+	}
+}
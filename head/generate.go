@@ -1,3 +1,7 @@
 package head
 
-//go:generate go run download.go
+// go generate refuses to write files that have no recorded hash, printing
+// their hashes instead. Review them against the upstream release, then rerun
+// with -accept-new to record them.
+//
+//go:generate go run ../cmd/pico_templ assets update -dir .
@@ -0,0 +1,178 @@
+package head
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// DefaultAssetPrefix is the URL prefix used when Props.AssetPrefix is empty.
+const DefaultAssetPrefix = "/assets"
+
+// AssetMode selects how Head includes the embedded assets.
+type AssetMode int
+
+const (
+	// AssetModeInline inlines asset contents into every page (default).
+	AssetModeInline AssetMode = iota
+	// AssetModeServed references assets served by AssetHandler under content-hashed URLs.
+	AssetModeServed
+	// AssetModeCDN references the upstream AssetURLs, falling back to the
+	// AssetHandler copy when the CDN fails to load.
+	AssetModeCDN
+)
+
+// servedAsset is an embedded asset addressable by its content-hashed name.
+type servedAsset struct {
+	name        string // Embedded file name, e.g. pico.min.css
+	hashedName  string // Content-hashed file name, e.g. pico.min.0123456789ab.css
+	contentType string
+	etag        string
+	integrity   string // Subresource Integrity value, e.g. sha256-...
+	data        []byte
+}
+
+// servedAssets indexes the embedded assets by embedded and hashed name.
+var servedAssets, hashedAssets = loadServedAssets()
+
+// picoVariantsRegistered records whether RegisterPicoVariants has been called,
+// i.e. whether the picovariants package is imported.
+var picoVariantsRegistered bool
+
+// loadServedAssets reads the embedded assets and computes their hashed names.
+func loadServedAssets() (byName, byHash map[string]*servedAsset) {
+	byName = map[string]*servedAsset{}
+	byHash = map[string]*servedAsset{}
+	entries, err := fs.ReadDir(Assets, "assets")
+	if err != nil {
+		return byName, byHash
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		data, err := Assets.ReadFile("assets/" + entry.Name())
+		if err != nil {
+			continue
+		}
+		asset := newServedAsset(entry.Name(), data)
+		byName[asset.name] = asset
+		byHash[asset.hashedName] = asset
+	}
+	return byName, byHash
+}
+
+// newServedAsset returns the served asset for the named file content.
+func newServedAsset(name string, data []byte) *servedAsset {
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+	ext := path.Ext(name)
+	return &servedAsset{
+		name:        name,
+		hashedName:  strings.TrimSuffix(name, ext) + "." + digest[:12] + ext,
+		contentType: contentType(ext),
+		etag:        `"` + digest + `"`,
+		integrity:   "sha256-" + base64.StdEncoding.EncodeToString(sum[:]),
+		data:        data,
+	}
+}
+
+// RegisterPicoVariants adds the Pico CSS variant stylesheets at the root of
+// fsys to the assets Head includes and AssetHandler serves, ignoring other
+// files. The picovariants package calls it when imported; call it only from
+// an init function, before any request is served.
+func RegisterPicoVariants(fsys fs.FS) error {
+	variants := map[string]bool{}
+	for _, file := range PicoVariants() {
+		variants[file] = true
+	}
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return fmt.Errorf("head: failed to read Pico CSS variants: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !variants[entry.Name()] {
+			continue
+		}
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return fmt.Errorf("head: failed to read Pico CSS variant %s: %w", entry.Name(), err)
+		}
+		asset := newServedAsset(entry.Name(), data)
+		servedAssets[asset.name] = asset
+		hashedAssets[asset.hashedName] = asset
+	}
+	picoVariantsRegistered = true
+	return nil
+}
+
+// contentType returns the Content-Type for an asset file extension.
+func contentType(ext string) string {
+	switch ext {
+	case ".css":
+		return "text/css; charset=utf-8"
+	case ".js":
+		return "text/javascript; charset=utf-8"
+	}
+	return "application/octet-stream"
+}
+
+// HashedName returns the content-hashed file name of an embedded asset, e.g.
+// "pico.min.0123456789ab.css", or an empty string if the asset does not exist.
+func HashedName(name string) string {
+	if asset, ok := servedAssets[name]; ok {
+		return asset.hashedName
+	}
+	return ""
+}
+
+// Integrity returns the Subresource Integrity value of an embedded asset, e.g.
+// "sha256-...", or an empty string if the asset does not exist.
+func Integrity(name string) string {
+	if asset, ok := servedAssets[name]; ok {
+		return asset.integrity
+	}
+	return ""
+}
+
+// AssetURL returns the URL of an embedded asset served by AssetHandler under prefix.
+func AssetURL(prefix, name string) string {
+	if prefix == "" {
+		prefix = DefaultAssetPrefix
+	}
+	return strings.TrimSuffix(prefix, "/") + "/" + HashedName(name)
+}
+
+// AssetHandler returns an http.Handler serving the embedded assets under their
+// content-hashed names with immutable caching, ETag and Range support. Mount it
+// with the prefix stripped:
+//
+//	mux.Handle("/assets/", http.StripPrefix("/assets", head.AssetHandler()))
+func AssetHandler() http.Handler {
+	return http.HandlerFunc(serveAsset)
+}
+
+// serveAsset serves a single content-hashed asset.
+func serveAsset(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	asset, ok := hashedAssets[strings.TrimPrefix(r.URL.Path, "/")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", asset.contentType)
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("ETag", asset.etag)
+	http.ServeContent(w, r, asset.name, time.Time{}, bytes.NewReader(asset.data))
+}
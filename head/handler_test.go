@@ -0,0 +1,261 @@
+package head
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func serve(t *testing.T, req *http.Request) *http.Response {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	http.StripPrefix("/assets", AssetHandler()).ServeHTTP(rec, req)
+	return rec.Result()
+}
+
+func TestHashedNameIncludesContentHash(t *testing.T) {
+	data, err := GetPicoCSS()
+	if err != nil {
+		t.Fatalf("failed to get Pico CSS: %v", err)
+	}
+	sum := sha256.Sum256(data)
+	want := "pico.min." + hex.EncodeToString(sum[:])[:12] + ".css"
+
+	if got := HashedName("pico.min.css"); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if got := HashedName("missing.js"); got != "" {
+		t.Errorf("expected empty name for missing asset, got %s", got)
+	}
+}
+
+func TestAssetURL(t *testing.T) {
+	name := HashedName("htmx.min.js")
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{"", "/assets/" + name},
+		{"/static", "/static/" + name},
+		{"/static/", "/static/" + name},
+		{"https://cdn.example.com/app", "https://cdn.example.com/app/" + name},
+	}
+	for _, tt := range tests {
+		if got := AssetURL(tt.prefix, "htmx.min.js"); got != tt.want {
+			t.Errorf("AssetURL(%q): expected %s, got %s", tt.prefix, tt.want, got)
+		}
+	}
+}
+
+func TestAssetHandlerServesHashedAssets(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		getData     func() ([]byte, error)
+	}{
+		{"pico.min.css", "text/css; charset=utf-8", GetPicoCSS},
+		{"htmx.min.js", "text/javascript; charset=utf-8", GetHTMX},
+		{"_hyperscript.min.js", "text/javascript; charset=utf-8", GetHyperscript},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := serve(t, httptest.NewRequest(http.MethodGet, AssetURL("", tt.name), nil))
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("expected 200, got %d", resp.StatusCode)
+			}
+			if got := resp.Header.Get("Content-Type"); got != tt.contentType {
+				t.Errorf("expected Content-Type %s, got %s", tt.contentType, got)
+			}
+			if got := resp.Header.Get("Cache-Control"); !strings.Contains(got, "immutable") {
+				t.Errorf("expected immutable Cache-Control, got %s", got)
+			}
+			if resp.Header.Get("ETag") == "" {
+				t.Error("expected ETag header")
+			}
+			body, _ := io.ReadAll(resp.Body)
+			want, _ := tt.getData()
+			if string(body) != string(want) {
+				t.Error("expected body to match embedded asset")
+			}
+		})
+	}
+}
+
+func TestAssetHandlerNotModified(t *testing.T) {
+	url := AssetURL("", "htmx.min.js")
+	first := serve(t, httptest.NewRequest(http.MethodGet, url, nil))
+
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("If-None-Match", first.Header.Get("ETag"))
+	resp := serve(t, req)
+
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("expected 304, got %d", resp.StatusCode)
+	}
+}
+
+func TestAssetHandlerRange(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, AssetURL("", "pico.min.css"), nil)
+	req.Header.Set("Range", "bytes=0-9")
+	resp := serve(t, req)
+
+	if resp.StatusCode != http.StatusPartialContent {
+		t.Fatalf("expected 206, got %d", resp.StatusCode)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	data, _ := GetPicoCSS()
+	if string(body) != string(data[:10]) {
+		t.Errorf("expected first 10 bytes, got %q", body)
+	}
+}
+
+func TestAssetHandlerHead(t *testing.T) {
+	resp := serve(t, httptest.NewRequest(http.MethodHead, AssetURL("", "pico.min.css"), nil))
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestAssetHandlerRejectsUnknownPaths(t *testing.T) {
+	paths := []string{"/assets/pico.min.css", "/assets/nope.0123456789ab.css", "/assets/"}
+	for _, path := range paths {
+		resp := serve(t, httptest.NewRequest(http.MethodGet, path, nil))
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("%s: expected 404, got %d", path, resp.StatusCode)
+		}
+	}
+}
+
+func TestAssetHandlerRejectsPost(t *testing.T) {
+	resp := serve(t, httptest.NewRequest(http.MethodPost, AssetURL("", "pico.min.css"), nil))
+
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405, got %d", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Allow"); got != "GET, HEAD" {
+		t.Errorf("expected Allow header, got %s", got)
+	}
+}
+
+func TestHeadServedModeReferencesHandlerURLs(t *testing.T) {
+	props := DefaultProps()
+	props.Title = "Test"
+	props.AssetMode = AssetModeServed
+
+	html := renderHead(t, props)
+
+	expectations := []string{
+		`<link rel="stylesheet" href="` + AssetURL("", "pico.min.css") + `" integrity="` + Integrity("pico.min.css") + `" crossorigin="anonymous">`,
+		`<script src="` + AssetURL("", "htmx.min.js") + `" integrity="` + Integrity("htmx.min.js") + `" crossorigin="anonymous"></script>`,
+		`<script src="` + AssetURL("", "_hyperscript.min.js") + `" integrity="` + Integrity("_hyperscript.min.js") + `" crossorigin="anonymous"></script>`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+	if strings.Contains(html, "<style>") || len(html) > 2000 {
+		t.Error("expected assets not to be inlined in served mode")
+	}
+
+	// Every referenced URL must be served by the handler.
+	for _, match := range regexp.MustCompile(`(?:href|src)="([^"]+)"`).FindAllStringSubmatch(html, -1) {
+		resp := serve(t, httptest.NewRequest(http.MethodGet, match[1], nil))
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("%s: expected 200, got %d", match[1], resp.StatusCode)
+		}
+	}
+}
+
+func TestHeadServedModeCustomPrefix(t *testing.T) {
+	props := DefaultProps()
+	props.Title = "Test"
+	props.AssetMode = AssetModeServed
+	props.AssetPrefix = "/static/"
+
+	html := renderHead(t, props)
+
+	if !strings.Contains(html, `href="/static/`+HashedName("pico.min.css")+`"`) {
+		t.Errorf("expected custom prefix, got: %s", html)
+	}
+}
+
+func TestHeadServedModeRespectsIncludeFlags(t *testing.T) {
+	props := Props{Title: "Test", AssetMode: AssetModeServed, IncludeHTMX: true}
+
+	html := renderHead(t, props)
+
+	if strings.Contains(html, "pico.min") || strings.Contains(html, "_hyperscript") {
+		t.Errorf("expected only HTMX to be referenced, got: %s", html)
+	}
+	if !strings.Contains(html, HashedName("htmx.min.js")) {
+		t.Errorf("expected HTMX script, got: %s", html)
+	}
+}
+
+func TestIntegrityMatchesEmbeddedBytes(t *testing.T) {
+	for name := range AssetHashes {
+		data, err := Assets.ReadFile("assets/" + name)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", name, err)
+		}
+		sum := sha256.Sum256(data)
+		want := "sha256-" + base64.StdEncoding.EncodeToString(sum[:])
+		if got := Integrity(name); got != want {
+			t.Errorf("%s: expected %s, got %s", name, want, got)
+		}
+	}
+	if got := Integrity("missing.js"); got != "" {
+		t.Errorf("expected empty integrity for missing asset, got %s", got)
+	}
+}
+
+func TestHeadCDNModeReferencesAssetURLs(t *testing.T) {
+	props := DefaultProps()
+	props.Title = "Test"
+	props.AssetMode = AssetModeCDN
+
+	html := renderHead(t, props)
+
+	expectations := []string{
+		`<link rel="stylesheet" href="` + AssetURLs["pico.min.css"] + `" integrity="` + Integrity("pico.min.css") + `" crossorigin="anonymous">`,
+		`<script src="` + AssetURLs["htmx.min.js"] + `" integrity="` + Integrity("htmx.min.js") + `" crossorigin="anonymous"></script>`,
+		`<script src="` + AssetURLs["_hyperscript.min.js"] + `" integrity="` + Integrity("_hyperscript.min.js") + `" crossorigin="anonymous"></script>`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+	if len(html) > 4000 {
+		t.Error("expected assets not to be inlined in CDN mode")
+	}
+}
+
+func TestHeadCDNModeFallsBackToHandler(t *testing.T) {
+	props := Props{Title: "Test", AssetMode: AssetModeCDN, AssetPrefix: "/static", IncludePico: true, IncludeHTMX: true}
+
+	html := renderHeadWithNonce(t, props, "abc123")
+
+	expectations := []string{
+		`<script nonce="abc123">if(!(document.querySelector("link[href=\"` + AssetURLs["pico.min.css"] + `\"]").sheet))document.write("\u003clink rel=\"stylesheet\" href=\"` + AssetURL("/static", "pico.min.css") + `\" crossorigin=\"anonymous\" integrity=\"` + Integrity("pico.min.css") + `\"\u003e")</script>`,
+		`<script nonce="abc123">if(!(window.htmx))document.write("\u003cscript src=\"` + AssetURL("/static", "htmx.min.js") + `\" crossorigin=\"anonymous\" integrity=\"` + Integrity("htmx.min.js") + `\" nonce=\"abc123\"\u003e\u003c/script\u003e")</script>`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+	if strings.Contains(html, "_hyperscript") {
+		t.Errorf("expected _hyperscript to be omitted, got: %s", html)
+	}
+}
@@ -194,6 +194,53 @@ func TestAssetHashesMatchEmbeddedFiles(t *testing.T) {
 	}
 }
 
+func TestEveryEmbeddedAssetIsHashed(t *testing.T) {
+	entries, err := Assets.ReadDir("assets")
+	if err != nil {
+		t.Fatalf("failed to read assets: %v", err)
+	}
+	for _, entry := range entries {
+		data, _ := Assets.ReadFile("assets/" + entry.Name())
+		hash := sha256.Sum256(data)
+		if actualHash := hex.EncodeToString(hash[:]); actualHash != AssetHashes[entry.Name()] {
+			t.Errorf("hash mismatch for %s: expected %q, got %s", entry.Name(), AssetHashes[entry.Name()], actualHash)
+		}
+	}
+	variants := map[string]bool{}
+	for _, file := range PicoVariants() {
+		variants[file] = true
+	}
+	for file := range AssetHashes {
+		if _, err := Assets.ReadFile("assets/" + file); err != nil && !variants[file] {
+			t.Errorf("hashed asset %s is not embedded", file)
+		}
+	}
+}
+
+func TestSourcesCoverAssetURLs(t *testing.T) {
+	files := 0
+	for _, source := range Sources() {
+		if source.Version == "" {
+			t.Errorf("expected version for %s", source.Package)
+		}
+		files += len(source.Files)
+	}
+	if files != len(AssetURLs) {
+		t.Errorf("expected a URL per source file, got %d URLs for %d files", len(AssetURLs), files)
+	}
+	if want := DefaultBaseURL + "/htmx.org@" + HTMXVersion + "/dist/htmx.min.js"; AssetURLs["htmx.min.js"] != want {
+		t.Errorf("expected %s, got %s", want, AssetURLs["htmx.min.js"])
+	}
+}
+
+func TestAssetHashesAreDeclaredSources(t *testing.T) {
+	for file := range AssetHashes {
+		if AssetURLs[file] == "" {
+			t.Errorf("hashed asset %s is not declared by Sources", file)
+		}
+	}
+}
+
 func TestDefaultProps(t *testing.T) {
 	props := DefaultProps()
 
@@ -223,6 +270,109 @@ func TestHeadContainsRequiredMetaTags(t *testing.T) {
 	}
 }
 
+func TestMetadataRender(t *testing.T) {
+	props := Props{
+		Title:       "Launch",
+		Description: "We launched",
+		Canonical:   "https://example.com/launch",
+		Robots:      "noindex, nofollow",
+		ThemeColor:  "#1095c1",
+		OpenGraph: OpenGraph{
+			Type:     "article",
+			Image:    "https://example.com/card.png",
+			ImageAlt: "Launch card",
+			SiteName: "Example",
+			Locale:   "en_GB",
+		},
+		Twitter: TwitterCard{
+			Card:    "summary_large_image",
+			Site:    "@example",
+			Creator: "@author",
+		},
+		Icons: Icons{
+			Favicon:    "/favicon.ico",
+			SVG:        "/icon.svg",
+			AppleTouch: "/apple-touch-icon.png",
+		},
+		Manifest: "/site.webmanifest",
+	}
+
+	html := renderHead(t, props)
+
+	expectations := []string{
+		`<link rel="canonical" href="https://example.com/launch">`,
+		`<meta name="robots" content="noindex, nofollow">`,
+		`<meta name="theme-color" content="#1095c1">`,
+		`<meta property="og:type" content="article">`,
+		`<meta property="og:title" content="Launch">`,
+		`<meta property="og:description" content="We launched">`,
+		`<meta property="og:url" content="https://example.com/launch">`,
+		`<meta property="og:image" content="https://example.com/card.png">`,
+		`<meta property="og:image:alt" content="Launch card">`,
+		`<meta property="og:site_name" content="Example">`,
+		`<meta property="og:locale" content="en_GB">`,
+		`<meta name="twitter:card" content="summary_large_image">`,
+		`<meta name="twitter:site" content="@example">`,
+		`<meta name="twitter:creator" content="@author">`,
+		`<link rel="icon" href="/favicon.ico">`,
+		`<link rel="icon" type="image/svg+xml" href="/icon.svg">`,
+		`<link rel="apple-touch-icon" href="/apple-touch-icon.png">`,
+		`<link rel="manifest" href="/site.webmanifest">`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+}
+
+func TestOpenGraphOverridesPageValues(t *testing.T) {
+	props := Props{
+		Title:     "Page",
+		Canonical: "https://example.com/page",
+		OpenGraph: OpenGraph{Title: "Shared title", URL: "https://example.com/share"},
+	}
+
+	html := renderHead(t, props)
+
+	if !strings.Contains(html, `<meta property="og:title" content="Shared title">`) {
+		t.Error("expected og:title override")
+	}
+	if !strings.Contains(html, `<meta property="og:url" content="https://example.com/share">`) {
+		t.Error("expected og:url override")
+	}
+	if strings.Contains(html, "og:description") {
+		t.Error("expected og:description to be omitted without a description")
+	}
+}
+
+func TestMetadataOmittedWhenEmpty(t *testing.T) {
+	html := renderHead(t, Props{Title: "Test"})
+
+	for _, unexpected := range []string{"canonical", "robots", "theme-color", "og:", "twitter:", `rel="icon"`, "manifest"} {
+		if strings.Contains(html, unexpected) {
+			t.Errorf("expected no %s tag, got: %s", unexpected, html)
+		}
+	}
+}
+
+func TestMetadataIsEscaped(t *testing.T) {
+	props := Props{
+		Title:     "Test",
+		Canonical: "javascript:alert(1)",
+		OpenGraph: OpenGraph{Title: `"><script>alert(1)</script>`},
+	}
+
+	html := renderHead(t, props)
+
+	if strings.Contains(html, "<script>alert(1)</script>") {
+		t.Error("expected og:title to be escaped")
+	}
+	if strings.Contains(html, "javascript:") {
+		t.Errorf("expected unsafe canonical URL to be sanitized, got: %s", html)
+	}
+}
+
 func renderHead(t *testing.T, props Props) string {
 	t.Helper()
 	var buf bytes.Buffer
@@ -0,0 +1,68 @@
+package head
+
+import "github.com/a-h/templ"
+
+// OpenGraph contains OpenGraph metadata for link previews. Title and
+// Description default to the page's, and URL to the canonical URL.
+type OpenGraph struct {
+	Type        string // og:type, e.g. "website" or "article"
+	Title       string // og:title (default Props.Title)
+	Description string // og:description (default Props.Description)
+	URL         string // og:url (default Props.Canonical)
+	Image       string // og:image absolute URL
+	ImageAlt    string // og:image:alt
+	SiteName    string // og:site_name
+	Locale      string // og:locale, e.g. "en_GB"
+}
+
+// TwitterCard contains Twitter (X) card metadata. Cards fall back to the
+// OpenGraph title, description and image.
+type TwitterCard struct {
+	Card     string // twitter:card, e.g. "summary" or "summary_large_image"
+	Site     string // twitter:site, the site's @username
+	Creator  string // twitter:creator, the author's @username
+	Image    string // twitter:image, when different from og:image
+	ImageAlt string // twitter:image:alt
+}
+
+// Icons contains favicon links.
+type Icons struct {
+	Favicon    string // Favicon URL (rel="icon")
+	SVG        string // SVG favicon URL (rel="icon" type="image/svg+xml")
+	AppleTouch string // Apple touch icon URL (rel="apple-touch-icon")
+}
+
+// isSet reports whether any OpenGraph field is set.
+func (o OpenGraph) isSet() bool {
+	return o != OpenGraph{}
+}
+
+// ogTitle returns the OpenGraph title.
+func (p Props) ogTitle() string {
+	if p.OpenGraph.Title != "" {
+		return p.OpenGraph.Title
+	}
+	return p.Title
+}
+
+// ogDescription returns the OpenGraph description.
+func (p Props) ogDescription() string {
+	if p.OpenGraph.Description != "" {
+		return p.OpenGraph.Description
+	}
+	return p.Description
+}
+
+// ogURL returns the OpenGraph URL.
+func (p Props) ogURL() string {
+	if p.OpenGraph.URL != "" {
+		return p.OpenGraph.URL
+	}
+	return p.Canonical
+}
+
+// sanitizeURL returns u, or a harmless placeholder if it uses an unsafe scheme
+// such as javascript:.
+func sanitizeURL(u string) string {
+	return string(templ.URL(u))
+}
@@ -0,0 +1,120 @@
+package head
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Color is a Pico CSS theme color, selecting the accent used for links,
+// buttons and form controls.
+type Color string
+
+// Pico CSS theme colors.
+const (
+	ColorDefault Color = "" // Pico default (azure)
+	ColorAmber   Color = "amber"
+	ColorAzure   Color = "azure"
+	ColorBlue    Color = "blue"
+	ColorCyan    Color = "cyan"
+	ColorFuchsia Color = "fuchsia"
+	ColorGreen   Color = "green"
+	ColorGrey    Color = "grey"
+	ColorIndigo  Color = "indigo"
+	ColorJade    Color = "jade"
+	ColorLime    Color = "lime"
+	ColorOrange  Color = "orange"
+	ColorPink    Color = "pink"
+	ColorPumpkin Color = "pumpkin"
+	ColorPurple  Color = "purple"
+	ColorRed     Color = "red"
+	ColorSand    Color = "sand"
+	ColorSlate   Color = "slate"
+	ColorViolet  Color = "violet"
+	ColorYellow  Color = "yellow"
+	ColorZinc    Color = "zinc"
+)
+
+// Colors lists every Pico CSS theme color.
+var Colors = []Color{
+	ColorAmber, ColorAzure, ColorBlue, ColorCyan, ColorFuchsia,
+	ColorGreen, ColorGrey, ColorIndigo, ColorJade, ColorLime,
+	ColorOrange, ColorPink, ColorPumpkin, ColorPurple, ColorRed,
+	ColorSand, ColorSlate, ColorViolet, ColorYellow, ColorZinc,
+}
+
+// Build is a Pico CSS build variant.
+type Build string
+
+// Pico CSS build variants.
+const (
+	BuildDefault                   Build = ""                            // Class-based styles
+	BuildClassless                 Build = "classless"                   // Styles semantic HTML without classes
+	BuildConditional               Build = "conditional"                 // Styles only inside .pico containers
+	BuildClasslessConditional      Build = "classless.conditional"       // Classless, only inside .pico containers
+	BuildFluidClassless            Build = "fluid.classless"             // Classless with a fluid-width main container
+	BuildFluidClasslessConditional Build = "fluid.classless.conditional" // Fluid classless, only inside .pico containers
+)
+
+// Builds lists every Pico CSS build variant.
+var Builds = []Build{
+	BuildDefault, BuildClassless, BuildConditional,
+	BuildClasslessConditional, BuildFluidClassless, BuildFluidClasslessConditional,
+}
+
+// Validate returns an error if c is not a Pico CSS theme color.
+func (c Color) Validate() error {
+	if c == ColorDefault {
+		return nil
+	}
+	for _, color := range Colors {
+		if c == color {
+			return nil
+		}
+	}
+	return fmt.Errorf("head: invalid Pico CSS theme color %q", string(c))
+}
+
+// Validate returns an error if b is not a Pico CSS build variant.
+func (b Build) Validate() error {
+	for _, build := range Builds {
+		if b == build {
+			return nil
+		}
+	}
+	return fmt.Errorf("head: invalid Pico CSS build %q", string(b))
+}
+
+// PicoFile returns the file name of a Pico CSS variant, e.g.
+// "pico.classless.jade.min.css", or an error if build or color is invalid.
+func PicoFile(build Build, color Color) (string, error) {
+	if err := build.Validate(); err != nil {
+		return "", err
+	}
+	if err := color.Validate(); err != nil {
+		return "", err
+	}
+	parts := []string{"pico"}
+	if build != BuildDefault {
+		parts = append(parts, string(build))
+	}
+	if color != ColorDefault {
+		parts = append(parts, string(color))
+	}
+	return strings.Join(append(parts, "min", "css"), "."), nil
+}
+
+// PicoVariants returns the file names of every Pico CSS build and color
+// variant other than the default pico.min.css.
+func PicoVariants() []string {
+	var files []string
+	for _, build := range Builds {
+		for _, color := range append([]Color{ColorDefault}, Colors...) {
+			if build == BuildDefault && color == ColorDefault {
+				continue
+			}
+			file, _ := PicoFile(build, color)
+			files = append(files, file)
+		}
+	}
+	return files
+}
@@ -0,0 +1,115 @@
+package head
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestPicoFile(t *testing.T) {
+	tests := []struct {
+		build Build
+		color Color
+		want  string
+	}{
+		{BuildDefault, ColorDefault, "pico.min.css"},
+		{BuildDefault, ColorJade, "pico.jade.min.css"},
+		{BuildClassless, ColorDefault, "pico.classless.min.css"},
+		{BuildClassless, ColorAmber, "pico.classless.amber.min.css"},
+		{BuildConditional, ColorViolet, "pico.conditional.violet.min.css"},
+		{BuildFluidClasslessConditional, ColorZinc, "pico.fluid.classless.conditional.zinc.min.css"},
+	}
+	for _, tt := range tests {
+		got, err := PicoFile(tt.build, tt.color)
+		if err != nil {
+			t.Fatalf("PicoFile(%q, %q): unexpected error: %v", tt.build, tt.color, err)
+		}
+		if got != tt.want {
+			t.Errorf("PicoFile(%q, %q): expected %s, got %s", tt.build, tt.color, tt.want, got)
+		}
+	}
+}
+
+func TestPicoFileRejectsInvalidVariants(t *testing.T) {
+	if _, err := PicoFile(BuildDefault, Color("teal")); err == nil {
+		t.Error("expected error for invalid color")
+	}
+	if _, err := PicoFile(Build("fluid"), ColorDefault); err == nil {
+		t.Error("expected error for invalid build")
+	}
+}
+
+func TestPicoVariants(t *testing.T) {
+	variants := PicoVariants()
+
+	if want := len(Builds)*(len(Colors)+1) - 1; len(variants) != want {
+		t.Errorf("expected %d variants, got %d", want, len(variants))
+	}
+	seen := map[string]bool{}
+	for _, file := range variants {
+		if seen[file] || file == "pico.min.css" {
+			t.Errorf("unexpected duplicate variant %s", file)
+		}
+		seen[file] = true
+		if !strings.HasPrefix(AssetURLs[file], DefaultBaseURL+"/@picocss/pico@"+PicoCSSVersion+"/css/") {
+			t.Errorf("expected CDN URL for %s, got %q", file, AssetURLs[file])
+		}
+	}
+}
+
+func TestHeadThemeVariant(t *testing.T) {
+	props := DefaultProps()
+	props.Title = "Test"
+	props.AssetMode = AssetModeServed
+	props.Theme = ColorJade
+	props.Build = BuildClassless
+
+	var buf bytes.Buffer
+	if err := Head(props).Render(context.Background(), &buf); err == nil || !strings.Contains(err.Error(), picoVariantsPackage) {
+		t.Fatalf("expected unavailable variant error, got %v", err)
+	}
+
+	t.Cleanup(func() {
+		picoVariantsRegistered = false
+		delete(hashedAssets, HashedName("pico.classless.jade.min.css"))
+		delete(servedAssets, "pico.classless.jade.min.css")
+	})
+	err := RegisterPicoVariants(fstest.MapFS{
+		"pico.classless.jade.min.css": {Data: []byte(":root{--pico-primary:jade}")},
+		"notes.txt":                   {Data: []byte("ignored")},
+	})
+	if err != nil {
+		t.Fatalf("failed to register variants: %v", err)
+	}
+	if HashedName("notes.txt") != "" {
+		t.Error("expected non-variant files to be ignored")
+	}
+
+	missing := props
+	missing.Theme = ColorRed
+	if err := Head(missing).Render(context.Background(), &buf); err == nil || !strings.Contains(err.Error(), "not vendored") || !strings.Contains(err.Error(), "-accept-new") {
+		t.Errorf("expected vendoring steps for a missing variant, got %v", err)
+	}
+
+	html := renderHead(t, props)
+	if !strings.Contains(html, `href="`+AssetURL("", "pico.classless.jade.min.css")+`"`) {
+		t.Errorf("expected selected variant, got: %s", html)
+	}
+
+	props.AssetMode = AssetModeInline
+	if html := renderHead(t, props); !strings.Contains(html, "--pico-primary:jade") {
+		t.Errorf("expected inlined variant, got: %s", html)
+	}
+}
+
+func TestHeadInvalidTheme(t *testing.T) {
+	props := DefaultProps()
+	props.Theme = Color("teal")
+
+	var buf bytes.Buffer
+	if err := Head(props).Render(context.Background(), &buf); err == nil {
+		t.Error("expected render error for invalid theme")
+	}
+}
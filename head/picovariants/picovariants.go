@@ -0,0 +1,31 @@
+// Package picovariants embeds the Pico CSS theme color and build variants,
+// keeping them out of binaries that only use the default pico.min.css.
+// Import it for its side effect to enable head.Props.Theme and Build:
+//
+//	import _ "github.com/markopolo123/pico_templ/head/picovariants"
+//
+// pico_templ assets update writes the stylesheets to the css directory and
+// records their hashes in head.AssetHashes.
+package picovariants
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/markopolo123/pico_templ/head"
+)
+
+// CSS contains the Pico CSS variant stylesheets under css/.
+//
+//go:embed all:css
+var CSS embed.FS
+
+func init() {
+	variants, err := fs.Sub(CSS, "css")
+	if err != nil {
+		panic(err)
+	}
+	if err := head.RegisterPicoVariants(variants); err != nil {
+		panic(err)
+	}
+}
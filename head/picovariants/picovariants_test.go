@@ -0,0 +1,66 @@
+package picovariants_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/markopolo123/pico_templ/head"
+	"github.com/markopolo123/pico_templ/head/picovariants"
+)
+
+func TestVendoredVariantsMatchManifest(t *testing.T) {
+	variants := map[string]bool{}
+	for _, file := range head.PicoVariants() {
+		variants[file] = true
+	}
+	entries, err := fs.ReadDir(picovariants.CSS, "css")
+	if err != nil {
+		t.Fatalf("failed to read variants: %v", err)
+	}
+	for _, entry := range entries {
+		if entry.Name() == ".gitkeep" {
+			continue
+		}
+		if !variants[entry.Name()] {
+			t.Errorf("unexpected file %s", entry.Name())
+			continue
+		}
+		data, _ := fs.ReadFile(picovariants.CSS, "css/"+entry.Name())
+		sum := sha256.Sum256(data)
+		if hash := hex.EncodeToString(sum[:]); hash != head.AssetHashes[entry.Name()] {
+			t.Errorf("hash mismatch for %s: expected %q, got %s", entry.Name(), head.AssetHashes[entry.Name()], hash)
+		}
+	}
+	for file := range variants {
+		if _, err := fs.Stat(picovariants.CSS, "css/"+file); err != nil && head.AssetHashes[file] != "" {
+			t.Errorf("hashed variant %s is not embedded", file)
+		}
+	}
+}
+
+func TestImportRegistersVariants(t *testing.T) {
+	props := head.DefaultProps()
+	props.AssetMode = head.AssetModeServed
+	props.Theme = head.ColorJade
+	file, _ := head.PicoFile(props.Build, props.Theme)
+
+	var buf bytes.Buffer
+	err := head.Head(props).Render(context.Background(), &buf)
+	if head.HashedName(file) == "" {
+		if err == nil || !strings.Contains(err.Error(), "not vendored") {
+			t.Errorf("expected vendoring steps for %s, got %v", file, err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	if !strings.Contains(buf.String(), head.AssetURL("", file)) {
+		t.Errorf("expected %s, got: %s", file, buf.String())
+	}
+}
@@ -1,14 +1,36 @@
 package head
 
-import "github.com/a-h/templ"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
+	"github.com/markopolo123/pico_templ/attrs"
+)
 
 // Props contains configuration options for the Head component.
 type Props struct {
 	Title              string          // Page title
 	Description        string          // Meta description
+	Canonical          string          // Canonical URL
+	Robots             string          // Robots directives, e.g. "noindex, nofollow"
+	ThemeColor         string          // Browser UI color (theme-color)
+	OpenGraph          OpenGraph       // OpenGraph link preview metadata
+	Twitter            TwitterCard     // Twitter card metadata
+	Icons              Icons           // Favicon and apple-touch icon links
+	Manifest           string          // Web app manifest URL
 	IncludePico        bool            // Include Pico CSS (default true)
+	Theme              Color           // Pico CSS theme color (default azure), needs the picovariants package
+	Build              Build           // Pico CSS build variant (default class-based), needs the picovariants package
 	IncludeHTMX        bool            // Include HTMX (default true)
 	IncludeHyperscript bool            // Include _hyperscript (default true)
+	Extensions         []string        // HTMX extensions to include, e.g. "sse", "preload" (implies HTMX)
+	AssetMode          AssetMode       // Inline assets (default), AssetHandler URLs or CDN URLs
+	AssetPrefix        string          // URL prefix AssetHandler is mounted at (default "/assets"), also used for CDN fallbacks
 	ExtraHead          templ.Component // Additional head content
 }
 
@@ -21,27 +43,133 @@ func DefaultProps() Props {
 	}
 }
 
-// picoCSS returns the embedded Pico CSS as a string.
-func picoCSS() string {
-	data, err := GetPicoCSS()
+// embedded returns the named embedded or registered asset as a string.
+func embedded(file string) string {
+	if asset, ok := servedAssets[file]; ok {
+		return string(asset.data)
+	}
+	return ""
+}
+
+// picoVariantsPackage embeds the Pico CSS variants and registers them when imported.
+const picoVariantsPackage = "github.com/markopolo123/pico_templ/head/picovariants"
+
+// vendorSteps tells maintainers how to vendor a declared asset that has no
+// recorded hash yet.
+const vendorSteps = "run go generate ./head to print the upstream hashes, review them, " +
+	"then run go run ./cmd/pico_templ assets update -dir head -accept-new"
+
+// picoFile returns the file name of the selected Pico CSS variant, or an error
+// if Theme or Build is invalid or the variant is not available.
+func (p Props) picoFile() (string, error) {
+	file, err := PicoFile(p.Build, p.Theme)
 	if err != nil {
-		return ""
+		return "", err
 	}
-	return string(data)
+	if _, ok := servedAssets[file]; ok {
+		return file, nil
+	}
+	if !picoVariantsRegistered {
+		return "", fmt.Errorf("head: Pico CSS variant %s is not available, import %s", file, picoVariantsPackage)
+	}
+	return "", fmt.Errorf("head: Pico CSS variant %s is not vendored in %s, %s", file, picoVariantsPackage, vendorSteps)
 }
 
-// htmxJS returns the embedded HTMX JS as a string.
-func htmxJS() string {
-	data, err := GetHTMX()
+// pico renders the selected Pico CSS variant, or fails to render if it is unavailable.
+func (p Props) pico() templ.Component {
+	file, err := p.picoFile()
 	if err != nil {
-		return ""
+		return templ.ComponentFunc(func(context.Context, io.Writer) error {
+			return err
+		})
 	}
-	return string(data)
+	return picoStylesheet(p, file)
+}
+
+// script includes the named embedded script unless it has already been
+// included in this render, marking it as included in the asset registry.
+func (p Props) script(asset assets.Asset) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		registry := assets.FromContext(ctx)
+		if registry.Included(string(asset)) {
+			return nil
+		}
+		registry.Include(string(asset))
+		return scriptTag(p, string(asset)).Render(ctx, w)
+	})
+}
+
+// assetURL returns the AssetHandler URL for the named embedded asset.
+func (p Props) assetURL(name string) templ.SafeURL {
+	return templ.SafeURL(AssetURL(p.AssetPrefix, name))
+}
+
+// cdnLoaded maps each script to a JavaScript expression that is truthy once the
+// script has loaded.
+var cdnLoaded = map[string]string{
+	"htmx.min.js":         `window.htmx`,
+	"_hyperscript.min.js": `window._hyperscript`,
+}
+
+// cdnStylesheetLoaded returns a JavaScript expression that is truthy once the
+// CDN stylesheet for the named asset has loaded.
+func cdnStylesheetLoaded(name string) string {
+	selector, _ := json.Marshal(`link[href="` + AssetURLs[name] + `"]`)
+	return "document.querySelector(" + string(selector) + ").sheet"
 }
 
-// hyperscriptJS returns the embedded _hyperscript JS as a string.
-func hyperscriptJS() string {
-	data, err := GetHyperscript()
+// cdnURL returns the upstream CDN URL for the named embedded asset.
+func cdnURL(name string) templ.SafeURL {
+	return templ.SafeURL(AssetURLs[name])
+}
+
+// cdnFallback writes an inline script that loads the AssetHandler copy of the
+// named asset when the CDN copy rendered before it failed to load. Scripts
+// without a load check in cdnLoaded, such as HTMX extensions, have no fallback.
+func (p Props) cdnFallback(name string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		var tag strings.Builder
+		element := templ.Attributes{
+			"integrity":   Integrity(name),
+			"crossorigin": "anonymous",
+		}
+		loaded := cdnLoaded[name]
+		if strings.HasSuffix(name, ".css") {
+			loaded = cdnStylesheetLoaded(name)
+			tag.WriteString(`<link rel="stylesheet" href="` + templ.EscapeString(AssetURL(p.AssetPrefix, name)) + `"`)
+		} else if loaded == "" {
+			return nil
+		} else {
+			tag.WriteString(`<script src="` + templ.EscapeString(AssetURL(p.AssetPrefix, name)) + `"`)
+			if nonce := Nonce(ctx); nonce != "" {
+				element["nonce"] = nonce
+			}
+		}
+		if err := templ.RenderAttributes(ctx, &tag, element); err != nil {
+			return err
+		}
+		if strings.HasSuffix(name, ".css") {
+			tag.WriteString(">")
+		} else {
+			tag.WriteString("></script>")
+		}
+		// json.Marshal escapes <, > and &, so the tag is safe inside a script element.
+		markup, err := json.Marshal(tag.String())
+		if err != nil {
+			return err
+		}
+		script := "if(!(" + loaded + "))document.write(" + string(markup) + ")"
+		return rawScript(script).Render(ctx, w)
+	})
+}
+
+// htmxConfig returns the htmx-config meta content applying the CSP nonce to
+// the inline scripts and styles HTMX inserts.
+func htmxConfig(nonce string) string {
+	data, err := json.Marshal(map[string]string{
+		"inlineScriptNonce": nonce,
+		"inlineStyleNonce":  nonce,
+	})
 	if err != nil {
 		return ""
 	}
@@ -50,10 +178,25 @@ func hyperscriptJS() string {
 
 // rawStyle creates a raw style element with the given CSS content.
 func rawStyle(css string) templ.Component {
-	return templ.Raw("<style>" + css + "</style>")
+	return rawElement("style", css)
 }
 
 // rawScript creates a raw script element with the given JS content.
 func rawScript(js string) templ.Component {
-	return templ.Raw("<script>" + js + "</script>")
+	return rawElement("script", js)
+}
+
+// rawElement writes content unescaped inside tag, adding the CSP nonce from
+// the render context if one is set.
+func rawElement(tag, content string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if _, err := io.WriteString(w, "<"+tag); err != nil {
+			return err
+		}
+		if err := templ.RenderAttributes(ctx, w, attrs.Nonce(ctx)); err != nil {
+			return err
+		}
+		_, err := io.WriteString(w, ">"+content+"</"+tag+">")
+		return err
+	})
 }
@@ -1,22 +1,68 @@
 // Package head provides a Head component with embedded versioned assets.
 package head
 
-const (
-	PicoCSSVersion     = "2.1.1"
-	HTMXVersion        = "2.0.4"
-	HyperscriptVersion = "0.9.14"
-)
-
-// AssetHashes contains expected SHA256 hashes for integrity verification.
-var AssetHashes = map[string]string{
-	"pico.min.css":        "d909404e60ea5ddec11a48b55292f110f713c6c30ab4d9b9bfaa0f31f363ca6f",
-	"htmx.min.js":         "69caefd0da92269066e725d7fe175e26b9d50c962e3056459c0c477154cdb9d3",
-	"_hyperscript.min.js": "3e834a3ffc0334fee54ecff4e37a6ae951cd83e6daa96651ca7cfd8f751ad4d2",
+import "sort"
+
+// DefaultBaseURL is the npm CDN the vendored assets are downloaded from and
+// referenced at in AssetModeCDN.
+const DefaultBaseURL = "https://cdn.jsdelivr.net/npm"
+
+// Source is an npm package head vendors assets from.
+type Source struct {
+	Package string            // npm package name, e.g. htmx.org
+	Version string            // Vendored version
+	Files   map[string]string // Embedded file name to path within the package
 }
 
-// AssetURLs contains download URLs for each asset.
-var AssetURLs = map[string]string{
-	"pico.min.css":        "https://cdn.jsdelivr.net/npm/@picocss/pico@" + PicoCSSVersion + "/css/pico.min.css",
-	"htmx.min.js":         "https://unpkg.com/htmx.org@" + HTMXVersion + "/dist/htmx.min.js",
-	"_hyperscript.min.js": "https://unpkg.com/hyperscript.org@" + HyperscriptVersion + "/dist/_hyperscript.min.js",
+// URL returns the download URL of the embedded file under baseURL.
+func (s Source) URL(baseURL, file string) string {
+	return baseURL + "/" + s.Package + "@" + s.Version + "/" + s.Files[file]
+}
+
+// FileNames returns the embedded file names of s in alphabetical order.
+func (s Source) FileNames() []string {
+	files := make([]string, 0, len(s.Files))
+	for file := range s.Files {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// Sources returns the npm packages head vendors assets from: Pico CSS with
+// every variant, HTMX, _hyperscript and the HTMX extensions. The versions
+// and hashes are recorded in manifest.go by pico_templ assets update.
+func Sources() []Source {
+	pico := Source{Package: "@picocss/pico", Version: PicoCSSVersion, Files: map[string]string{
+		"pico.min.css": "css/pico.min.css",
+	}}
+	for _, file := range PicoVariants() {
+		pico.Files[file] = "css/" + file
+	}
+	sources := []Source{
+		pico,
+		{Package: "htmx.org", Version: HTMXVersion, Files: map[string]string{
+			"htmx.min.js": "dist/htmx.min.js",
+		}},
+		{Package: "hyperscript.org", Version: HyperscriptVersion, Files: map[string]string{
+			"_hyperscript.min.js": "dist/_hyperscript.min.js",
+		}},
+	}
+	for _, name := range Extensions() {
+		sources = append(sources, Source{Package: "htmx-ext-" + name, Version: HTMXExtensionVersions[name], Files: map[string]string{
+			ExtensionFile(name): name + ".js",
+		}})
+	}
+	return sources
+}
+
+// AssetURLs contains the CDN URL of each asset.
+var AssetURLs = map[string]string{}
+
+func init() {
+	for _, source := range Sources() {
+		for file := range source.Files {
+			AssetURLs[file] = source.URL(DefaultBaseURL, file)
+		}
+	}
 }
@@ -0,0 +1,180 @@
+package htmx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+func TestFromRequest(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/items", nil)
+	r.Header.Set("HX-Request", "true")
+	r.Header.Set("HX-Boosted", "true")
+	r.Header.Set("HX-Current-URL", "http://example.com/items?page=2")
+	r.Header.Set("HX-Prompt", "yes")
+	r.Header.Set("HX-Target", "result")
+	r.Header.Set("HX-Trigger", "save")
+	r.Header.Set("HX-Trigger-Name", "action")
+
+	want := Request{
+		IsHtmx:      true,
+		Boosted:     true,
+		CurrentURL:  "http://example.com/items?page=2",
+		Prompt:      "yes",
+		Target:      "result",
+		Trigger:     "save",
+		TriggerName: "action",
+	}
+	if got := FromRequest(r); got != want {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestFromRequest_NonHtmx(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	if got := FromRequest(r); got != (Request{}) {
+		t.Errorf("expected zero request, got %+v", got)
+	}
+	if IsRequest(r) {
+		t.Error("expected IsRequest to be false")
+	}
+	r.Header.Set("HX-Request", "true")
+	if !IsRequest(r) {
+		t.Error("expected IsRequest to be true")
+	}
+}
+
+func TestRequest_Partial(t *testing.T) {
+	tests := []struct {
+		r    Request
+		want bool
+	}{
+		{Request{}, false},
+		{Request{IsHtmx: true}, true},
+		{Request{IsHtmx: true, Boosted: true}, false},
+		{Request{IsHtmx: true, HistoryRestore: true}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.r.Partial(); got != tt.want {
+			t.Errorf("%+v: expected %v, got %v", tt.r, tt.want, got)
+		}
+	}
+}
+
+func TestResponse_Headers(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := NewResponse(w)
+		resp.Redirect("/login")
+		resp.Location("/items")
+		resp.Refresh()
+		resp.PushURL("/items/1")
+		resp.ReplaceURL("false")
+		resp.Retarget("#errors")
+		resp.Reselect("#content")
+		if err := resp.Reswap(attrs.Swap{Strategy: attrs.SwapOuterHTML, Settle: 100 * time.Millisecond}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		w.Write([]byte("ok"))
+	})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	expectations := map[string]string{
+		"HX-Redirect":    "/login",
+		"HX-Location":    "/items",
+		"HX-Refresh":     "true",
+		"HX-Push-Url":    "/items/1",
+		"HX-Replace-Url": "false",
+		"HX-Retarget":    "#errors",
+		"HX-Reselect":    "#content",
+		"HX-Reswap":      "outerHTML settle:100ms",
+	}
+	for header, want := range expectations {
+		if got := rec.Result().Header.Get(header); got != want {
+			t.Errorf("expected %s %q, got %q", header, want, got)
+		}
+	}
+}
+
+func TestResponse_Reswap(t *testing.T) {
+	rec := httptest.NewRecorder()
+	resp := NewResponse(rec)
+
+	if err := resp.Reswap(attrs.Swap{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := rec.Header().Get("HX-Reswap"); got != "innerHTML" {
+		t.Errorf("expected default swap to be explicit, got %q", got)
+	}
+
+	rec = httptest.NewRecorder()
+	if err := NewResponse(rec).Reswap(attrs.Swap{Strategy: "morph"}); err == nil {
+		t.Error("expected invalid strategy error")
+	}
+	if _, ok := rec.Header()["Hx-Reswap"]; ok {
+		t.Error("expected no header for an invalid swap")
+	}
+}
+
+func TestResponse_Trigger(t *testing.T) {
+	rec := httptest.NewRecorder()
+	resp := NewResponse(rec)
+
+	if err := resp.Trigger(Event{Name: "itemSaved"}, Event{Name: "htmx:abort"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := rec.Header().Get("HX-Trigger"); got != "itemSaved, htmx:abort" {
+		t.Errorf("expected plain event list, got %q", got)
+	}
+
+	err := resp.TriggerAfterSettle(
+		Event{Name: "showMessage", Detail: map[string]string{"level": "info", "text": "Saved"}},
+		Event{Name: "refresh"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"refresh":null,"showMessage":{"level":"info","text":"Saved"}}`
+	if got := rec.Header().Get("HX-Trigger-After-Settle"); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+
+	if err := resp.TriggerAfterSwap(Event{Name: "done", Detail: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := rec.Header().Get("HX-Trigger-After-Swap"); got != `{"done":1}` {
+		t.Errorf("expected JSON detail, got %q", got)
+	}
+
+	if err := resp.Trigger(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := rec.Header().Get("HX-Trigger"); got != "" {
+		t.Errorf("expected no events to clear the header, got %q", got)
+	}
+}
+
+func TestResponse_TriggerRejectsInvalidEvents(t *testing.T) {
+	invalid := [][]Event{
+		{{Name: ""}},
+		{{Name: "item saved"}},
+		{{Name: "a,b"}},
+		{{Name: "saved"}, {Name: "saved", Detail: 1}},
+		{{Name: "saved", Detail: make(chan int)}},
+	}
+	for _, events := range invalid {
+		rec := httptest.NewRecorder()
+		err := NewResponse(rec).Trigger(events...)
+		if err == nil || !strings.HasPrefix(err.Error(), "htmx: ") {
+			t.Errorf("%v: expected error, got %v", events, err)
+		}
+		if got := rec.Header().Get("HX-Trigger"); got != "" {
+			t.Errorf("%v: expected no header, got %q", events, got)
+		}
+	}
+}
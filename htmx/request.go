@@ -0,0 +1,58 @@
+// Package htmx provides server-side helpers for handlers answering HTMX
+// requests: a typed view of the HX-* request headers and a helper setting the
+// HX-* response headers. Header names match the attributes in
+// attrs.HtmxAttrs, so a Response can retarget or reswap what an element's
+// hx-target and hx-swap asked for.
+package htmx
+
+import "net/http"
+
+// Request headers sent by HTMX.
+const (
+	HeaderRequest        = "HX-Request"                 // "true" on every HTMX request
+	HeaderBoosted        = "HX-Boosted"                 // "true" if the request comes from an hx-boost element
+	HeaderCurrentURL     = "HX-Current-URL"             // URL of the browser when the request was made
+	HeaderHistoryRestore = "HX-History-Restore-Request" // "true" if the request restores history after a cache miss
+	HeaderPrompt         = "HX-Prompt"                  // The user's response to hx-prompt
+	HeaderTarget         = "HX-Target"                  // ID of the target element, if it has one
+	HeaderTriggerName    = "HX-Trigger-Name"            // Name of the triggering element, if it has one
+	HeaderTrigger        = "HX-Trigger"                 // ID of the triggering element on requests; events to trigger on responses
+)
+
+// Request is the HTMX view of an HTTP request, read from its HX-* headers.
+// The zero value describes a regular, non-HTMX request.
+type Request struct {
+	IsHtmx         bool   // HX-Request: the request was made by HTMX
+	Boosted        bool   // HX-Boosted: the request comes from an hx-boost link or form
+	HistoryRestore bool   // HX-History-Restore-Request: the full page is needed to restore history
+	CurrentURL     string // HX-Current-URL
+	Prompt         string // HX-Prompt: the user's response to hx-prompt
+	Target         string // HX-Target: ID of the hx-target element
+	Trigger        string // HX-Trigger: ID of the element that triggered the request
+	TriggerName    string // HX-Trigger-Name: name of the element that triggered the request
+}
+
+// FromRequest returns the HTMX view of r.
+func FromRequest(r *http.Request) Request {
+	return Request{
+		IsHtmx:         r.Header.Get(HeaderRequest) == "true",
+		Boosted:        r.Header.Get(HeaderBoosted) == "true",
+		HistoryRestore: r.Header.Get(HeaderHistoryRestore) == "true",
+		CurrentURL:     r.Header.Get(HeaderCurrentURL),
+		Prompt:         r.Header.Get(HeaderPrompt),
+		Target:         r.Header.Get(HeaderTarget),
+		Trigger:        r.Header.Get(HeaderTrigger),
+		TriggerName:    r.Header.Get(HeaderTriggerName),
+	}
+}
+
+// IsRequest reports whether r was made by HTMX.
+func IsRequest(r *http.Request) bool {
+	return r.Header.Get(HeaderRequest) == "true"
+}
+
+// Partial reports whether the request expects a fragment rather than a full
+// page: it was made by HTMX, is not boosted and does not restore history.
+func (r Request) Partial() bool {
+	return r.IsHtmx && !r.Boosted && !r.HistoryRestore
+}
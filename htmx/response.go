@@ -0,0 +1,159 @@
+package htmx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+// Response headers understood by HTMX. HX-Trigger is HeaderTrigger.
+const (
+	HeaderLocation           = "HX-Location"             // Client-side redirect without a full page reload
+	HeaderPushURL            = "HX-Push-Url"             // Push a URL onto the history stack
+	HeaderRedirect           = "HX-Redirect"             // Client-side redirect with a full page reload
+	HeaderRefresh            = "HX-Refresh"              // "true" for a full page refresh
+	HeaderReplaceURL         = "HX-Replace-Url"          // Replace the current URL in the location bar
+	HeaderReswap             = "HX-Reswap"               // Override hx-swap
+	HeaderRetarget           = "HX-Retarget"             // Override hx-target with a CSS selector
+	HeaderReselect           = "HX-Reselect"             // Override hx-select with a CSS selector
+	HeaderTriggerAfterSettle = "HX-Trigger-After-Settle" // Events to trigger after the settle step
+	HeaderTriggerAfterSwap   = "HX-Trigger-After-Swap"   // Events to trigger after the swap step
+)
+
+// StatusStopPolling is the response status that stops an hx-trigger="every"
+// poll.
+const StatusStopPolling = 286
+
+// Event is a client-side event triggered through an HX-Trigger response
+// header.
+type Event struct {
+	Name   string // Event name, e.g. itemSaved or htmx:abort
+	Detail any    // Optional event detail, encoded as JSON
+}
+
+// Response sets HTMX response headers. Create it with NewResponse before
+// writing the status or body; headers set afterwards are ignored by
+// net/http.
+type Response struct {
+	header http.Header
+}
+
+// NewResponse returns a Response setting headers on w.
+func NewResponse(w http.ResponseWriter) Response {
+	return Response{header: w.Header()}
+}
+
+// Redirect makes HTMX load url with a full page reload.
+func (r Response) Redirect(url string) {
+	r.header.Set(HeaderRedirect, url)
+}
+
+// Location makes HTMX load path as if an hx-boost link had been followed,
+// without a full page reload.
+func (r Response) Location(path string) {
+	r.header.Set(HeaderLocation, path)
+}
+
+// Refresh makes HTMX reload the page.
+func (r Response) Refresh() {
+	r.header.Set(HeaderRefresh, "true")
+}
+
+// PushURL pushes url onto the browser history, overriding hx-push-url. Pass
+// "false" to prevent a push.
+func (r Response) PushURL(url string) {
+	r.header.Set(HeaderPushURL, url)
+}
+
+// ReplaceURL replaces the URL in the location bar, overriding
+// hx-replace-url. Pass "false" to prevent a replacement.
+func (r Response) ReplaceURL(url string) {
+	r.header.Set(HeaderReplaceURL, url)
+}
+
+// Retarget swaps the response into the elements matching selector instead
+// of the hx-target.
+func (r Response) Retarget(selector string) {
+	r.header.Set(HeaderRetarget, selector)
+}
+
+// Reselect swaps the part of the response matching selector, overriding
+// hx-select.
+func (r Response) Reselect(selector string) {
+	r.header.Set(HeaderReselect, selector)
+}
+
+// Reswap overrides the element's hx-swap. It returns an error and sets
+// nothing if swap is invalid.
+func (r Response) Reswap(swap attrs.Swap) error {
+	value, err := swap.Value()
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		value = string(attrs.SwapInnerHTML)
+	}
+	r.header.Set(HeaderReswap, value)
+	return nil
+}
+
+// Trigger triggers events on the client as soon as the response is
+// received, replacing events set by an earlier call. It returns an error and
+// sets nothing if an event name is invalid or a detail cannot be encoded.
+func (r Response) Trigger(events ...Event) error {
+	return r.trigger(HeaderTrigger, events)
+}
+
+// TriggerAfterSwap is Trigger for events fired after the swap step.
+func (r Response) TriggerAfterSwap(events ...Event) error {
+	return r.trigger(HeaderTriggerAfterSwap, events)
+}
+
+// TriggerAfterSettle is Trigger for events fired after the settle step.
+func (r Response) TriggerAfterSettle(events ...Event) error {
+	return r.trigger(HeaderTriggerAfterSettle, events)
+}
+
+// trigger sets header to the events: a comma separated list of names when
+// no event has a detail, or a JSON object mapping names to details.
+func (r Response) trigger(header string, events []Event) error {
+	value, err := triggerValue(events)
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		r.header.Del(header)
+		return nil
+	}
+	r.header.Set(header, value)
+	return nil
+}
+
+// triggerValue returns the header value for events.
+func triggerValue(events []Event) (string, error) {
+	names := make([]string, len(events))
+	details := make(map[string]any, len(events))
+	plain := true
+	for i, event := range events {
+		if err := (attrs.Trigger{Event: event.Name}).Validate(); err != nil {
+			return "", fmt.Errorf("htmx: invalid event name %q", event.Name)
+		}
+		if _, ok := details[event.Name]; ok {
+			return "", fmt.Errorf("htmx: duplicate event %q", event.Name)
+		}
+		names[i] = event.Name
+		details[event.Name] = event.Detail
+		plain = plain && event.Detail == nil
+	}
+	if plain {
+		return strings.Join(names, ", "), nil
+	}
+	data, err := json.Marshal(details)
+	if err != nil {
+		return "", fmt.Errorf("htmx: failed to encode event details: %w", err)
+	}
+	return string(data), nil
+}
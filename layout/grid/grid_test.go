@@ -0,0 +1,193 @@
+package grid
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/assets"
+)
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+func TestGrid_DefaultHasGridClass(t *testing.T) {
+	html := render(t, Grid(Props{}))
+
+	if html != `<div class="grid"></div>` {
+		t.Errorf("expected plain grid div, got: %s", html)
+	}
+}
+
+func TestGrid_DefaultOmitsStylesheet(t *testing.T) {
+	html := render(t, Grid(Props{CollapseBelow: Medium}))
+
+	if strings.Contains(html, "<style>") || strings.Contains(html, "data-collapse") {
+		t.Errorf("default breakpoint should not need extra CSS, got: %s", html)
+	}
+}
+
+func TestGrid_CustomClassesAppend(t *testing.T) {
+	html := render(t, Grid(Props{Class: "  cards  "}))
+
+	if !strings.Contains(html, `class="grid cards"`) {
+		t.Errorf("expected trimmed custom class to be appended, got: %s", html)
+	}
+}
+
+func TestGrid_Columns(t *testing.T) {
+	html := render(t, Grid(Props{Columns: 3}))
+
+	if !strings.Contains(html, `data-columns="3"`) {
+		t.Errorf("expected data-columns attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `.grid[data-columns="3"]{--grid-columns:repeat(3,minmax(0,1fr))}`) {
+		t.Errorf("expected column stylesheet, got: %s", html)
+	}
+}
+
+func TestGrid_InvalidColumnsIgnored(t *testing.T) {
+	for _, columns := range []int{-1, 13} {
+		html := render(t, Grid(Props{Columns: columns}))
+		if strings.Contains(html, "data-columns") {
+			t.Errorf("expected columns=%d to be ignored, got: %s", columns, html)
+		}
+	}
+}
+
+func TestGrid_Gap(t *testing.T) {
+	tests := []struct {
+		gap  Gap
+		want string
+	}{
+		{GapNone, `data-gap="none"`},
+		{GapSmall, `data-gap="sm"`},
+		{GapLarge, `data-gap="lg"`},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.gap), func(t *testing.T) {
+			html := render(t, Grid(Props{Gap: tt.gap}))
+			if !strings.Contains(html, tt.want) {
+				t.Errorf("expected %s, got: %s", tt.want, html)
+			}
+		})
+	}
+}
+
+func TestGrid_CollapseBelow(t *testing.T) {
+	tests := []struct {
+		breakpoint Breakpoint
+		rule       string
+	}{
+		{Small, `@media (min-width:576px){.grid[data-collapse=sm]`},
+		{Large, `@media (max-width:1023.98px){.grid[data-collapse=lg]{grid-template-columns:1fr}`},
+		{XLarge, `@media (max-width:1279.98px){.grid[data-collapse=xl]`},
+		{XXLarge, `@media (max-width:1535.98px){.grid[data-collapse=xxl]`},
+		{Never, `.grid[data-collapse=never]{grid-template-columns:`},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.breakpoint), func(t *testing.T) {
+			html := render(t, Grid(Props{CollapseBelow: tt.breakpoint}))
+			if !strings.Contains(html, `data-collapse="`+string(tt.breakpoint)+`"`) {
+				t.Errorf("expected data-collapse attribute, got: %s", html)
+			}
+			if !strings.Contains(html, tt.rule) {
+				t.Errorf("expected rule %s, got: %s", tt.rule, html)
+			}
+		})
+	}
+}
+
+func TestGrid_StylesheetRenderedOnce(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := templ.InitializeContext(context.Background())
+	for i := 0; i < 2; i++ {
+		if err := Grid(Props{Columns: 2}).Render(ctx, &buf); err != nil {
+			t.Fatalf("failed to render component: %v", err)
+		}
+	}
+
+	if count := strings.Count(buf.String(), "<style>"); count != 1 {
+		t.Errorf("expected stylesheet once, got %d: %s", count, buf.String())
+	}
+}
+
+func TestGrid_StylesheetCarriesNonce(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := templ.WithNonce(context.Background(), "abc123")
+	if err := Grid(Props{Columns: 2}).Render(ctx, &buf); err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), `<style nonce="abc123">`) {
+		t.Errorf("expected nonce on stylesheet, got: %s", buf.String())
+	}
+}
+
+func TestGrid_AttrsSpreadIntoElement(t *testing.T) {
+	html := render(t, Grid(Props{
+		Attrs: templ.Attributes{"id": "main-grid"},
+	}))
+
+	if !strings.Contains(html, `id="main-grid"`) {
+		t.Errorf("expected id attribute, got: %s", html)
+	}
+}
+
+func TestColumn_Default(t *testing.T) {
+	html := render(t, Column(ColumnProps{}))
+
+	if html != `<div></div>` {
+		t.Errorf("expected plain div, got: %s", html)
+	}
+}
+
+func TestColumn_Span(t *testing.T) {
+	html := render(t, Column(ColumnProps{Span: 2}))
+
+	if !strings.Contains(html, `data-span="2"`) {
+		t.Errorf("expected data-span attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `.grid>[data-span="2"]{--grid-span:span 2}`) {
+		t.Errorf("expected span stylesheet, got: %s", html)
+	}
+}
+
+func TestColumn_ClassAndAttrs(t *testing.T) {
+	html := render(t, Column(ColumnProps{Class: "sidebar", Attrs: templ.Attributes{"data-testid": "col"}}))
+
+	if !strings.Contains(html, `class="sidebar"`) {
+		t.Errorf("expected class attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `data-testid="col"`) {
+		t.Errorf("expected data-testid attribute, got: %s", html)
+	}
+}
+
+func TestGrid_StylesheetDeferredToRegistry(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := assets.WithRegistry(context.Background())
+	if err := Grid(Props{Columns: 2}).Render(ctx, &buf); err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	if err := Column(ColumnProps{Span: 2}).Render(ctx, &buf); err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+
+	if strings.Contains(buf.String(), "<style") {
+		t.Errorf("expected no inline stylesheet, got: %s", buf.String())
+	}
+	styles := assets.FromContext(ctx).Pending().Styles
+	if len(styles) != 1 || styles[0].CSS != gridCSS {
+		t.Errorf("expected the grid stylesheet to be required once, got %v", styles)
+	}
+}
@@ -0,0 +1,132 @@
+package group
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/components/button"
+	"github.com/markopolo123/pico_templ/forms/input"
+)
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+func TestGroup_DefaultRendersDivWithRole(t *testing.T) {
+	html := render(t, Group(Props{}))
+
+	if html != `<div role="group"></div>` {
+		t.Errorf("expected div with role=group, got: %s", html)
+	}
+}
+
+func TestGroup_Fieldset(t *testing.T) {
+	html := render(t, Group(Props{Fieldset: true}))
+
+	if html != `<fieldset role="group"></fieldset>` {
+		t.Errorf("expected fieldset with role=group, got: %s", html)
+	}
+}
+
+func TestGroup_ClassAndAttrs(t *testing.T) {
+	html := render(t, Group(Props{Class: "toolbar", Attrs: templ.Attributes{"aria-label": "Actions"}}))
+
+	if !strings.Contains(html, `class="toolbar"`) {
+		t.Errorf("expected class attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `aria-label="Actions"`) {
+		t.Errorf("expected aria-label attribute, got: %s", html)
+	}
+}
+
+func TestSearchGroup_Defaults(t *testing.T) {
+	html := render(t, SearchGroup(SearchProps{
+		Input: input.Props{Name: "q", Placeholder: "Search", Label: "Ignored", HelperText: "Ignored"},
+	}))
+
+	expectations := []string{
+		`<fieldset role="group">`,
+		`type="search"`,
+		`name="q"`,
+		`<button type="submit">Search</button>`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+	if strings.Contains(html, "<label") || strings.Contains(html, "<small") || strings.Contains(html, "Ignored") {
+		t.Errorf("expected label and helper text to be stripped, got: %s", html)
+	}
+}
+
+func TestSearchGroup_CustomButton(t *testing.T) {
+	html := render(t, SearchGroup(SearchProps{
+		Input:  input.Props{Name: "q", Type: "text"},
+		Button: button.Props{Text: "Go", Variant: button.Secondary, HxGet: "/search"},
+	}))
+
+	if !strings.Contains(html, `type="text"`) {
+		t.Errorf("expected explicit input type to be kept, got: %s", html)
+	}
+	if !strings.Contains(html, `class="secondary"`) || !strings.Contains(html, `hx-get="/search"`) || !strings.Contains(html, `>Go</button>`) {
+		t.Errorf("expected button props to be applied, got: %s", html)
+	}
+}
+
+func TestCopyGroup(t *testing.T) {
+	html := render(t, CopyGroup(CopyProps{
+		Input: input.Props{Name: "token", Value: "abc123"},
+	}))
+
+	expectations := []string{
+		`<fieldset role="group">`,
+		`value="abc123"`,
+		`readonly`,
+		`>Copy</button>`,
+		`_="on click call navigator.clipboard.writeText((previous &lt;input/&gt;).value)"`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+}
+
+func TestCopyGroup_DoesNotMutateButtonAttrs(t *testing.T) {
+	attrs := templ.Attributes{"data-testid": "copy"}
+	html := render(t, CopyGroup(CopyProps{
+		Input:  input.Props{Name: "token"},
+		Button: button.Props{Attrs: attrs},
+	}))
+
+	if !strings.Contains(html, `data-testid="copy"`) {
+		t.Errorf("expected caller attrs to be kept, got: %s", html)
+	}
+	if _, ok := attrs["_"]; ok {
+		t.Error("expected caller attrs map not to be modified")
+	}
+}
+
+func TestButtonGroup(t *testing.T) {
+	html := render(t, ButtonGroup([]button.Props{
+		{Text: "Left"},
+		{Text: "Right", Variant: button.Secondary},
+	}))
+
+	if !strings.HasPrefix(html, `<div role="group">`) {
+		t.Errorf("expected div group, got: %s", html)
+	}
+	if strings.Count(html, "<button") != 2 {
+		t.Errorf("expected two buttons, got: %s", html)
+	}
+}
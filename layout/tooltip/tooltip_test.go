@@ -0,0 +1,81 @@
+package tooltip
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+func render(t *testing.T, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+func TestTooltip_RendersDataTooltip(t *testing.T) {
+	html := render(t, Tooltip(Props{Text: "More info"}))
+
+	if html != `<span data-tooltip="More info"></span>` {
+		t.Errorf("expected span with data-tooltip, got: %s", html)
+	}
+}
+
+func TestTooltip_EmptyTextRendersNoTooltip(t *testing.T) {
+	html := render(t, Tooltip(Props{Placement: attrs.PlacementLeft}))
+
+	if html != `<span></span>` {
+		t.Errorf("expected plain span, got: %s", html)
+	}
+}
+
+func TestTooltip_Placements(t *testing.T) {
+	placements := []attrs.Placement{
+		attrs.PlacementTop,
+		attrs.PlacementRight,
+		attrs.PlacementBottom,
+		attrs.PlacementLeft,
+	}
+	for _, placement := range placements {
+		t.Run(string(placement), func(t *testing.T) {
+			html := render(t, Tooltip(Props{Text: "Tip", Placement: placement}))
+			if !strings.Contains(html, `data-placement="`+string(placement)+`"`) {
+				t.Errorf("expected data-placement=%s, got: %s", placement, html)
+			}
+		})
+	}
+}
+
+func TestTooltip_InvalidPlacementFailsRender(t *testing.T) {
+	var buf bytes.Buffer
+	err := Tooltip(Props{Text: "Tip", Placement: "middle"}).Render(context.Background(), &buf)
+
+	if err == nil {
+		t.Fatalf("expected render error for invalid placement, got: %s", buf.String())
+	}
+	if !strings.Contains(err.Error(), `invalid tooltip placement "middle"`) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestTooltip_ClassAndAttrs(t *testing.T) {
+	html := render(t, Tooltip(Props{
+		Text:  "Tip",
+		Class: "help",
+		Attrs: templ.Attributes{"tabindex": "0"},
+	}))
+
+	if !strings.Contains(html, `class="help"`) {
+		t.Errorf("expected class attribute, got: %s", html)
+	}
+	if !strings.Contains(html, `tabindex="0"`) {
+		t.Errorf("expected tabindex attribute, got: %s", html)
+	}
+}
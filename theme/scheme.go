@@ -0,0 +1,139 @@
+package theme
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/markopolo123/pico_templ/htmx"
+)
+
+// Scheme is a Pico CSS color scheme, rendered as the data-theme attribute.
+type Scheme string
+
+// Color schemes supported by Pico CSS.
+const (
+	Auto  Scheme = ""      // Follow the browser's prefers-color-scheme
+	Light Scheme = "light" // Force the light scheme
+	Dark  Scheme = "dark"  // Force the dark scheme
+)
+
+// CookieName is the cookie storing the user's chosen scheme.
+const CookieName = "pico-theme"
+
+// HintHeader is the client hint carrying the browser's preferred color scheme.
+const HintHeader = "Sec-CH-Prefers-Color-Scheme"
+
+// cookieMaxAge is how long a chosen scheme is remembered.
+const cookieMaxAge = 365 * 24 * time.Hour
+
+// Parse returns the scheme named by s ("light", "dark" or "auto"), and false
+// if s is not a scheme.
+func Parse(s string) (Scheme, bool) {
+	switch Scheme(strings.ToLower(strings.Trim(strings.TrimSpace(s), `"`))) {
+	case Light:
+		return Light, true
+	case Dark:
+		return Dark, true
+	case "auto", Auto:
+		return Auto, true
+	}
+	return Auto, false
+}
+
+// Next returns the scheme a toggle switches to: Light from Dark, Dark otherwise.
+func (s Scheme) Next() Scheme {
+	if s == Dark {
+		return Light
+	}
+	return Dark
+}
+
+// FromRequest resolves the scheme for r from the CookieName cookie, falling
+// back to the Sec-CH-Prefers-Color-Scheme client hint and then Auto.
+func FromRequest(r *http.Request) Scheme {
+	if cookie, err := r.Cookie(CookieName); err == nil {
+		if scheme, ok := Parse(cookie.Value); ok {
+			return scheme
+		}
+	}
+	if scheme, ok := Parse(r.Header.Get(HintHeader)); ok {
+		return scheme
+	}
+	return Auto
+}
+
+// SetCookie stores scheme in the CookieName cookie, or clears it for Auto.
+func SetCookie(w http.ResponseWriter, scheme Scheme) {
+	cookie := &http.Cookie{
+		Name:     CookieName,
+		Value:    string(scheme),
+		Path:     "/",
+		MaxAge:   int(cookieMaxAge.Seconds()),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	if scheme == Auto {
+		cookie.MaxAge = -1
+	}
+	http.SetCookie(w, cookie)
+}
+
+type contextKey struct{}
+
+// WithScheme returns a context carrying scheme.
+func WithScheme(ctx context.Context, scheme Scheme) context.Context {
+	return context.WithValue(ctx, contextKey{}, scheme)
+}
+
+// FromContext returns the scheme set on ctx, or Auto if none is set.
+func FromContext(ctx context.Context) Scheme {
+	scheme, _ := ctx.Value(contextKey{}).(Scheme)
+	return scheme
+}
+
+// Middleware resolves the scheme of each request with FromRequest and stores
+// it in the request context. It asks the browser to send the color scheme
+// client hint, so first visits render in the right scheme without a cookie.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Accept-CH", HintHeader)
+		w.Header().Add("Critical-CH", HintHeader)
+		w.Header().Add("Vary", HintHeader)
+		w.Header().Add("Vary", "Cookie")
+		next.ServeHTTP(w, r.WithContext(WithScheme(r.Context(), FromRequest(r))))
+	})
+}
+
+// Handler returns an http.Handler for ThemeToggle requests. It stores the
+// posted "theme" form value in the cookie and responds with the updated
+// toggle for HTMX requests, or redirects back to the referring page.
+func Handler(props ToggleProps) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		scheme, ok := Parse(r.FormValue("theme"))
+		if !ok {
+			http.Error(w, "invalid theme", http.StatusBadRequest)
+			return
+		}
+		SetCookie(w, scheme)
+
+		if !htmx.IsRequest(r) {
+			target := r.Referer()
+			if target == "" {
+				target = "/"
+			}
+			http.Redirect(w, r, target, http.StatusSeeOther)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := ThemeToggle(props).Render(WithScheme(r.Context(), scheme), w); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,184 @@
+package theme
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/markopolo123/pico_templ/attrs"
+)
+
+// Theme describes overrides of Pico CSS custom properties. Empty fields keep
+// Pico's defaults.
+type Theme struct {
+	PrimaryHue   int               `json:"primaryHue,omitempty"`   // Hue (1-360) of the primary palette; 0 keeps Pico's azure, use 360 for red
+	FontFamily   string            `json:"fontFamily,omitempty"`   // --pico-font-family
+	Spacing      string            `json:"spacing,omitempty"`      // --pico-spacing, e.g. "1rem"
+	BorderRadius string            `json:"borderRadius,omitempty"` // --pico-border-radius, e.g. "0.5rem"
+	Light        Palette           `json:"light"`                  // Overrides for the light scheme
+	Dark         Palette           `json:"dark"`                   // Overrides for the dark scheme
+	Vars         map[string]string `json:"vars,omitempty"`         // Additional --pico-* variables for both schemes, without the prefix
+}
+
+// Palette holds color overrides for one color scheme. Explicit colors take
+// precedence over those derived from Theme.PrimaryHue.
+type Palette struct {
+	Background        string `json:"background,omitempty"`        // --pico-background-color
+	Color             string `json:"color,omitempty"`             // --pico-color
+	MutedColor        string `json:"mutedColor,omitempty"`        // --pico-muted-color
+	MutedBorderColor  string `json:"mutedBorderColor,omitempty"`  // --pico-muted-border-color
+	CardBackground    string `json:"cardBackground,omitempty"`    // --pico-card-background-color
+	Primary           string `json:"primary,omitempty"`           // --pico-primary
+	PrimaryBackground string `json:"primaryBackground,omitempty"` // --pico-primary-background
+	PrimaryInverse    string `json:"primaryInverse,omitempty"`    // --pico-primary-inverse
+}
+
+// Selectors matching Pico's own scheme rules, so the theme applies to forced
+// and automatic schemes alike.
+const (
+	lightSelector = ":root:not([data-theme=dark]),[data-theme=light]"
+	darkSelector  = "[data-theme=dark]"
+	autoSelector  = ":root:not([data-theme])"
+)
+
+// vars returns the palette as --pico-* variables.
+func (p Palette) vars() map[string]string {
+	return map[string]string{
+		"background-color":      p.Background,
+		"color":                 p.Color,
+		"muted-color":           p.MutedColor,
+		"muted-border-color":    p.MutedBorderColor,
+		"card-background-color": p.CardBackground,
+		"primary":               p.Primary,
+		"primary-background":    p.PrimaryBackground,
+		"primary-inverse":       p.PrimaryInverse,
+	}
+}
+
+// primaryVars derives the primary palette of a scheme from hue.
+func primaryVars(hue int, dark bool) map[string]string {
+	if hue == 0 {
+		return map[string]string{}
+	}
+	if dark {
+		return map[string]string{
+			"primary":                  fmt.Sprintf("hsl(%d, 80%%, 65%%)", hue),
+			"primary-background":       fmt.Sprintf("hsl(%d, 70%%, 45%%)", hue),
+			"primary-underline":        fmt.Sprintf("hsla(%d, 80%%, 65%%, 0.5)", hue),
+			"primary-hover":            fmt.Sprintf("hsl(%d, 80%%, 75%%)", hue),
+			"primary-hover-background": fmt.Sprintf("hsl(%d, 70%%, 52%%)", hue),
+			"primary-focus":            fmt.Sprintf("hsla(%d, 80%%, 65%%, 0.375)", hue),
+			"primary-inverse":          "#fff",
+		}
+	}
+	return map[string]string{
+		"primary":                  fmt.Sprintf("hsl(%d, 70%%, 40%%)", hue),
+		"primary-background":       fmt.Sprintf("hsl(%d, 70%%, 45%%)", hue),
+		"primary-underline":        fmt.Sprintf("hsla(%d, 70%%, 40%%, 0.5)", hue),
+		"primary-hover":            fmt.Sprintf("hsl(%d, 70%%, 30%%)", hue),
+		"primary-hover-background": fmt.Sprintf("hsl(%d, 70%%, 38%%)", hue),
+		"primary-focus":            fmt.Sprintf("hsla(%d, 70%%, 50%%, 0.5)", hue),
+		"primary-inverse":          "#fff",
+	}
+}
+
+// merge copies the non-empty values of src into dst.
+func merge(dst, src map[string]string) map[string]string {
+	for name, value := range src {
+		if value != "" {
+			dst[name] = value
+		}
+	}
+	return dst
+}
+
+// Validate returns an error if the hue is out of range or a value could
+// break out of its declaration.
+func (t Theme) Validate() error {
+	if t.PrimaryHue < 0 || t.PrimaryHue > 360 {
+		return fmt.Errorf("theme: primary hue %d out of range 0-360", t.PrimaryHue)
+	}
+	for _, vars := range []map[string]string{t.root(), t.Light.vars(), t.Dark.vars()} {
+		for name, value := range vars {
+			if name == "" || strings.ContainsAny(name, ";:{}<>\"' \n") {
+				return fmt.Errorf("theme: invalid variable name %q", name)
+			}
+			if strings.ContainsAny(value, ";{}<>\n") {
+				return fmt.Errorf("theme: invalid value %q for --pico-%s", value, name)
+			}
+		}
+	}
+	return nil
+}
+
+// root returns the scheme-independent variables.
+func (t Theme) root() map[string]string {
+	vars := merge(map[string]string{}, map[string]string{
+		"font-family":   t.FontFamily,
+		"spacing":       t.Spacing,
+		"border-radius": t.BorderRadius,
+	})
+	return merge(vars, t.Vars)
+}
+
+// CSS returns the theme as a stylesheet of --pico-* variables, to be loaded
+// after Pico CSS.
+func (t Theme) CSS() (string, error) {
+	if err := t.Validate(); err != nil {
+		return "", err
+	}
+	light := merge(primaryVars(t.PrimaryHue, false), t.Light.vars())
+	dark := merge(primaryVars(t.PrimaryHue, true), t.Dark.vars())
+
+	var b strings.Builder
+	writeRule(&b, ":root", t.root())
+	writeRule(&b, lightSelector, light)
+	writeRule(&b, darkSelector, dark)
+	if len(dark) > 0 {
+		b.WriteString("@media only screen and (prefers-color-scheme: dark) {\n")
+		writeRule(&b, autoSelector, dark)
+		b.WriteString("}\n")
+	}
+	return b.String(), nil
+}
+
+// writeRule writes a rule declaring vars, sorted by name, or nothing if vars is empty.
+func writeRule(b *strings.Builder, selector string, vars map[string]string) {
+	if len(vars) == 0 {
+		return
+	}
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	b.WriteString(selector + " {\n")
+	for _, name := range names {
+		b.WriteString("  --pico-" + name + ": " + vars[name] + ";\n")
+	}
+	b.WriteString("}\n")
+}
+
+// Style renders the theme in a style element carrying the CSP nonce, if any.
+// Use it in head.Props.ExtraHead so it loads after Pico CSS. Rendering fails
+// if the theme is invalid.
+func Style(t Theme) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		css, err := t.CSS()
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, "<style"); err != nil {
+			return err
+		}
+		if err := templ.RenderAttributes(ctx, w, attrs.Nonce(ctx)); err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, ">"+css+"</style>")
+		return err
+	})
+}
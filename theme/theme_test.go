@@ -0,0 +1,341 @@
+package theme
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func render(t *testing.T, ctx context.Context, component templ.Component) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := component.Render(ctx, &buf)
+	if err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return buf.String()
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Scheme
+		ok    bool
+	}{
+		{"light", Light, true},
+		{"dark", Dark, true},
+		{`"dark"`, Dark, true},
+		{"Dark", Dark, true},
+		{"auto", Auto, true},
+		{"", Auto, true},
+		{"purple", Auto, false},
+	}
+	for _, tt := range tests {
+		got, ok := Parse(tt.input)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Parse(%q): expected %q %v, got %q %v", tt.input, tt.want, tt.ok, got, ok)
+		}
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		cookie string
+		hint   string
+		want   Scheme
+	}{
+		{"nothing", "", "", Auto},
+		{"client hint", "", `"dark"`, Dark},
+		{"cookie", "light", "", Light},
+		{"cookie wins over hint", "light", `"dark"`, Light},
+		{"invalid cookie falls back to hint", "purple", `"dark"`, Dark},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: CookieName, Value: tt.cookie})
+			}
+			if tt.hint != "" {
+				r.Header.Set(HintHeader, tt.hint)
+			}
+			if got := FromRequest(r); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var scheme Scheme
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scheme = FromContext(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.AddCookie(&http.Cookie{Name: CookieName, Value: "dark"})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, r)
+
+	if scheme != Dark {
+		t.Errorf("expected dark scheme in context, got %q", scheme)
+	}
+	if got := rec.Header().Get("Accept-CH"); got != HintHeader {
+		t.Errorf("expected Accept-CH client hint, got %q", got)
+	}
+	if vary := strings.Join(rec.Header().Values("Vary"), ", "); !strings.Contains(vary, "Cookie") || !strings.Contains(vary, HintHeader) {
+		t.Errorf("expected Vary on cookie and client hint, got %q", vary)
+	}
+}
+
+func TestFromContextDefaultsToAuto(t *testing.T) {
+	if got := FromContext(context.Background()); got != Auto {
+		t.Errorf("expected auto, got %q", got)
+	}
+}
+
+func TestThemeToggle(t *testing.T) {
+	tests := []struct {
+		name     string
+		scheme   Scheme
+		contains []string
+		excludes []string
+	}{
+		{
+			name:   "auto switches to dark",
+			scheme: Auto,
+			contains: []string{
+				`hx-post="/theme"`,
+				`hx-vals="{&#34;theme&#34;:&#34;dark&#34;}"`,
+				`hx-swap="outerHTML"`,
+				`aria-label="Switch to dark theme"`,
+				`>Dark</button>`,
+			},
+			excludes: []string{`data-scheme="`},
+		},
+		{
+			name:   "dark switches to light",
+			scheme: Dark,
+			contains: []string{
+				`data-scheme="dark"`,
+				`hx-vals="{&#34;theme&#34;:&#34;light&#34;}"`,
+				`>Light</button>`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := render(t, WithScheme(context.Background(), tt.scheme), ThemeToggle(ToggleProps{}))
+			for _, exp := range tt.contains {
+				if !strings.Contains(html, exp) {
+					t.Errorf("expected %s, got: %s", exp, html)
+				}
+			}
+			for _, exp := range tt.excludes {
+				if strings.Contains(html, exp) {
+					t.Errorf("expected no %s, got: %s", exp, html)
+				}
+			}
+		})
+	}
+}
+
+func TestThemeToggle_CustomProps(t *testing.T) {
+	html := render(t, context.Background(), ThemeToggle(ToggleProps{
+		URL:       "/prefs/theme",
+		DarkLabel: "Lights off",
+		Class:     "theme-toggle",
+		Attrs:     templ.Attributes{"id": "toggle"},
+	}))
+
+	expectations := []string{
+		`class="secondary outline theme-toggle"`,
+		`hx-post="/prefs/theme"`,
+		`id="toggle"`,
+		`>Lights off</button>`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(html, exp) {
+			t.Errorf("expected %s, got: %s", exp, html)
+		}
+	}
+}
+
+func post(t *testing.T, values url.Values, htmx bool) *http.Response {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodPost, "/theme", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set("Referer", "/settings")
+	if htmx {
+		r.Header.Set("HX-Request", "true")
+	}
+	rec := httptest.NewRecorder()
+	Handler(ToggleProps{}).ServeHTTP(rec, r)
+	return rec.Result()
+}
+
+func TestHandler_HTMXRequest(t *testing.T) {
+	resp := post(t, url.Values{"theme": {"dark"}}, true)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	cookies := resp.Cookies()
+	if len(cookies) != 1 || cookies[0].Name != CookieName || cookies[0].Value != "dark" {
+		t.Errorf("expected dark theme cookie, got %v", cookies)
+	}
+	var body bytes.Buffer
+	body.ReadFrom(resp.Body)
+	if !strings.Contains(body.String(), `data-scheme="dark"`) || !strings.Contains(body.String(), `>Light</button>`) {
+		t.Errorf("expected updated toggle, got: %s", body.String())
+	}
+}
+
+func TestHandler_RedirectsWithoutHTMX(t *testing.T) {
+	resp := post(t, url.Values{"theme": {"light"}}, false)
+
+	if resp.StatusCode != http.StatusSeeOther {
+		t.Fatalf("expected 303, got %d", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Location"); got != "/settings" {
+		t.Errorf("expected redirect to referer, got %s", got)
+	}
+}
+
+func TestHandler_AutoClearsCookie(t *testing.T) {
+	resp := post(t, url.Values{"theme": {"auto"}}, true)
+
+	cookies := resp.Cookies()
+	if len(cookies) != 1 || cookies[0].MaxAge >= 0 {
+		t.Errorf("expected cookie to be cleared, got %v", cookies)
+	}
+}
+
+func TestHandler_RejectsInvalidRequests(t *testing.T) {
+	if resp := post(t, url.Values{"theme": {"purple"}}, true); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected 400 for invalid theme, got %d", resp.StatusCode)
+	}
+
+	rec := httptest.NewRecorder()
+	Handler(ToggleProps{}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/theme", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405 for GET, got %d", rec.Code)
+	}
+}
+
+func TestThemeCSS_Empty(t *testing.T) {
+	css, err := Theme{}.CSS()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if css != "" {
+		t.Errorf("expected empty stylesheet, got: %s", css)
+	}
+}
+
+func TestThemeCSS_RootVariables(t *testing.T) {
+	css, err := Theme{
+		FontFamily:   "Inter, sans-serif",
+		Spacing:      "1.25rem",
+		BorderRadius: "0.5rem",
+		Vars:         map[string]string{"form-element-spacing-vertical": "0.5rem"},
+	}.CSS()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := ":root {\n" +
+		"  --pico-border-radius: 0.5rem;\n" +
+		"  --pico-font-family: Inter, sans-serif;\n" +
+		"  --pico-form-element-spacing-vertical: 0.5rem;\n" +
+		"  --pico-spacing: 1.25rem;\n" +
+		"}\n"
+	if css != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, css)
+	}
+}
+
+func TestThemeCSS_PrimaryHue(t *testing.T) {
+	css, err := Theme{PrimaryHue: 150}.CSS()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectations := []string{
+		lightSelector + " {\n  --pico-primary: hsl(150, 70%, 40%);",
+		darkSelector + " {\n  --pico-primary: hsl(150, 80%, 65%);",
+		"@media only screen and (prefers-color-scheme: dark) {\n" + autoSelector + " {\n  --pico-primary: hsl(150, 80%, 65%);",
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(css, exp) {
+			t.Errorf("expected %q, got:\n%s", exp, css)
+		}
+	}
+	if strings.Contains(css, ":root {") {
+		t.Errorf("expected no scheme-independent rule, got:\n%s", css)
+	}
+}
+
+func TestThemeCSS_PaletteOverridesHue(t *testing.T) {
+	css, err := Theme{
+		PrimaryHue: 150,
+		Light:      Palette{Primary: "#0a0", Background: "#fafafa"},
+		Dark:       Palette{Background: "#111"},
+	}.CSS()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectations := []string{
+		"--pico-primary: #0a0;",
+		"--pico-background-color: #fafafa;",
+		"--pico-background-color: #111;",
+		"--pico-primary: hsl(150, 80%, 65%);",
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(css, exp) {
+			t.Errorf("expected %q, got:\n%s", exp, css)
+		}
+	}
+	if strings.Contains(css, "hsl(150, 70%, 40%)") {
+		t.Errorf("expected explicit light primary to replace the derived one, got:\n%s", css)
+	}
+}
+
+func TestThemeValidate(t *testing.T) {
+	invalid := []Theme{
+		{PrimaryHue: 400},
+		{PrimaryHue: -1},
+		{FontFamily: "x; } body { display: none"},
+		{Dark: Palette{Background: "</style><script>"}},
+		{Vars: map[string]string{"a b": "1"}},
+	}
+	for _, theme := range invalid {
+		if err := theme.Validate(); err == nil {
+			t.Errorf("expected error for %+v", theme)
+		}
+	}
+}
+
+func TestStyle(t *testing.T) {
+	ctx := templ.WithNonce(context.Background(), "abc123")
+	html := render(t, ctx, Style(Theme{Spacing: "2rem"}))
+
+	if html != "<style nonce=\"abc123\">:root {\n  --pico-spacing: 2rem;\n}\n</style>" {
+		t.Errorf("unexpected style element: %s", html)
+	}
+}
+
+func TestStyle_InvalidThemeFailsToRender(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Style(Theme{PrimaryHue: 999}).Render(context.Background(), &buf); err == nil {
+		t.Error("expected render error for invalid theme")
+	}
+}